@@ -0,0 +1,96 @@
+// Package auth authenticates the callers of the admin service and of
+// the fanouts with static tokens, JWTs, HMAC signatures or client
+// certificates, and authorizes the admin service's callers with roles
+// scoped to fanout name prefixes.
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ErrNoCredentials is returned by authenticators when the request
+// has no credentials they can verify.
+var ErrNoCredentials = errors.New("no credentials")
+
+// Identity is an authenticated caller.
+type Identity struct {
+	// Subject is the name of the static token, the JWT's subject
+	// or the client certificate's identity.
+	Subject string
+
+	// Method is how the caller is authenticated;
+	// token, api_key, hmac, jwt or mtls.
+	Method string
+}
+
+// Authenticator authenticates the caller of a request.
+type Authenticator interface {
+	// Authenticate returns the caller's identity. It returns
+	// ErrNoCredentials if the request has no credentials the
+	// authenticator can verify, and other errors if it has
+	// invalid credentials.
+	Authenticate(r *http.Request) (*Identity, error)
+}
+
+// Chain tries the authenticators in order until one of
+// them finds credentials in the request.
+type Chain []Authenticator
+
+func (c Chain) Authenticate(r *http.Request) (*Identity, error) {
+	for _, a := range c {
+		id, err := a.Authenticate(r)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+		return id, err
+	}
+	return nil, ErrNoCredentials
+}
+
+type resultKey struct{}
+
+type result struct {
+	id  *Identity
+	err error
+}
+
+// Middleware authenticates the requests and keeps the outcome in their
+// context, to be checked with FromContext. Requests with missing or
+// invalid credentials are passed on, so they can be rejected in the
+// protocol of the handler, e.g. with Twirp errors.
+func Middleware(a Authenticator, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := a.Authenticate(r)
+		ctx := context.WithValue(r.Context(), resultKey{}, &result{id: id, err: err})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// NewContext returns a context with the authenticated caller.
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, resultKey{}, &result{id: id})
+}
+
+// FromContext returns the caller authenticated by Middleware or added
+// with NewContext. It returns ErrNoCredentials if the request isn't
+// authenticated.
+func FromContext(ctx context.Context) (*Identity, error) {
+	res, ok := ctx.Value(resultKey{}).(*result)
+	if !ok {
+		return nil, ErrNoCredentials
+	}
+	return res.id, res.err
+}
+
+// bearerToken returns the token in the request's Authorization
+// header, or empty if there is none.
+func bearerToken(r *http.Request) string {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
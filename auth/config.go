@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Config configures the authentication and the authorization
+// of the admin service. It is read from a JSON file, e.g.
+//
+//	{
+//	  "tokens": [{"subject": "deployer", "sha256": "9f86d0..."}],
+//	  "jwt": {"jwks_file": "/etc/dfanout/jwks.json", "issuer": "https://idp.example.com"},
+//	  "mtls": true,
+//	  "bindings": [
+//	    {"subject": "deployer", "role": "editor", "fanout_prefix": "checkout-"},
+//	    {"subject": "*", "role": "viewer"}
+//	  ]
+//	}
+type Config struct {
+	Tokens []Token    `json:"tokens,omitempty"`
+	JWT    *JWTConfig `json:"jwt,omitempty"`
+
+	// MTLS enables authenticating with client certificates,
+	// verified by the server's client CAs.
+	MTLS bool `json:"mtls,omitempty"`
+
+	Bindings []Binding `json:"bindings"`
+}
+
+// LoadConfig reads the config from the file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c Config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("invalid auth config %q: %w", path, err)
+	}
+	return &c, nil
+}
+
+// Authenticator returns the authenticators of the config, trying
+// static tokens first, then JWTs, then client certificates.
+func (c *Config) Authenticator() (Authenticator, error) {
+	var chain Chain
+	if len(c.Tokens) > 0 {
+		t, err := NewTokens(c.Tokens)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, t)
+	}
+	if c.JWT != nil {
+		j, err := NewJWT(*c.JWT)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, j)
+	}
+	if c.MTLS {
+		chain = append(chain, MTLS{})
+	}
+	if len(chain) == 0 {
+		return nil, errors.New("no authentication methods are configured")
+	}
+	return chain, nil
+}
+
+// Policy returns the policy of the config's bindings.
+func (c *Config) Policy() (*Policy, error) {
+	return NewPolicy(c.Bindings)
+}
@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader carries the signature of an HMAC signed request,
+	// "key_id=<id>,timestamp=<unix seconds>,signature=<hex>".
+	SignatureHeader = "X-Signature"
+
+	// DefaultMaxSkew is the default max difference between the
+	// timestamp of a signed request and the time it is received.
+	DefaultMaxSkew = 5 * time.Minute
+)
+
+// HMAC authenticates the requests signed with HMAC-SHA256. The
+// signature is of the timestamp, the method, the request URI and the
+// hex SHA-256 of the body, joined with newlines. The subject is the ID
+// of the key. Authenticate reads the request's body.
+type HMAC struct {
+	keys    map[string][]byte // ID -> secret
+	maxSkew time.Duration
+}
+
+// NewHMAC returns an authenticator for the requests signed
+// with the keys, by their IDs. If maxSkew is zero, it is
+// DefaultMaxSkew.
+func NewHMAC(keys map[string][]byte, maxSkew time.Duration) *HMAC {
+	if maxSkew <= 0 {
+		maxSkew = DefaultMaxSkew
+	}
+	return &HMAC{keys: keys, maxSkew: maxSkew}
+}
+
+func (h *HMAC) Authenticate(r *http.Request) (*Identity, error) {
+	v := r.Header.Get(SignatureHeader)
+	if v == "" {
+		return nil, ErrNoCredentials
+	}
+	var keyID, timestamp, signature string
+	for _, field := range strings.Split(v, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(field), "=")
+		switch name {
+		case "key_id":
+			keyID = value
+		case "timestamp":
+			timestamp = value
+		case "signature":
+			signature = value
+		}
+	}
+	secret, ok := h.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", keyID)
+	}
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid signature timestamp")
+	}
+	if skew := time.Since(time.Unix(sec, 0)); skew > h.maxSkew || skew < -h.maxSkew {
+		return nil, errors.New("signature timestamp is out of range")
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return nil, errors.New("invalid signature")
+	}
+
+	body := sha256.New()
+	if r.Body != nil {
+		if _, err := io.Copy(body, r.Body); err != nil {
+			return nil, fmt.Errorf("cannot read the body: %w", err)
+		}
+	}
+	mac := hmac.New(sha256.New, secret)
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%x", timestamp, r.Method, r.URL.RequestURI(), body.Sum(nil))
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return nil, errors.New("invalid signature")
+	}
+	return &Identity{Subject: keyID, Method: "hmac"}, nil
+}
@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// signature returns the signature header of a request.
+func signature(secret, keyID string, ts time.Time, method, uri, body string) string {
+	timestamp := strconv.FormatInt(ts.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%x", timestamp, method, uri, sha256.Sum256([]byte(body)))
+	return fmt.Sprintf("key_id=%s,timestamp=%s,signature=%x", keyID, timestamp, mac.Sum(nil))
+}
+
+func TestHMAC(t *testing.T) {
+	a := NewHMAC(map[string][]byte{"a": []byte("secret-a"), "b": []byte("secret-b")}, time.Minute)
+	now := time.Now()
+	const (
+		uri  = "/fanout/f?q=1"
+		body = `{"id":1}`
+	)
+	tests := []struct {
+		name          string
+		signature     string
+		want          string // subject, empty if rejected
+		noCredentials bool
+	}{
+		{
+			name:      "valid",
+			signature: signature("secret-a", "a", now, "POST", uri, body),
+			want:      "a",
+		},
+		{
+			name:      "another key",
+			signature: signature("secret-b", "b", now, "POST", uri, body),
+			want:      "b",
+		},
+		{
+			name:      "spaces between fields",
+			signature: strings.ReplaceAll(signature("secret-a", "a", now, "POST", uri, body), ",", ", "),
+			want:      "a",
+		},
+		{
+			name:      "within the skew in the past",
+			signature: signature("secret-a", "a", now.Add(-50*time.Second), "POST", uri, body),
+			want:      "a",
+		},
+		{
+			name:      "within the skew in the future",
+			signature: signature("secret-a", "a", now.Add(50*time.Second), "POST", uri, body),
+			want:      "a",
+		},
+		{
+			name:      "too old",
+			signature: signature("secret-a", "a", now.Add(-2*time.Minute), "POST", uri, body),
+		},
+		{
+			name:      "too far in the future",
+			signature: signature("secret-a", "a", now.Add(2*time.Minute), "POST", uri, body),
+		},
+		{
+			name:      "wrong secret",
+			signature: signature("secret-b", "a", now, "POST", uri, body),
+		},
+		{
+			name:      "unknown key",
+			signature: signature("secret-a", "c", now, "POST", uri, body),
+		},
+		{
+			name:      "another method",
+			signature: signature("secret-a", "a", now, "PUT", uri, body),
+		},
+		{
+			name:      "another URI",
+			signature: signature("secret-a", "a", now, "POST", "/fanout/f?q=2", body),
+		},
+		{
+			name:      "another body",
+			signature: signature("secret-a", "a", now, "POST", uri, `{"id":2}`),
+		},
+		{
+			name:      "invalid timestamp",
+			signature: "key_id=a,timestamp=now,signature=00",
+		},
+		{
+			name:      "invalid signature",
+			signature: "key_id=a,timestamp=" + strconv.FormatInt(now.Unix(), 10) + ",signature=zz",
+		},
+		{
+			name:      "no signature",
+			signature: "key_id=a,timestamp=" + strconv.FormatInt(now.Unix(), 10),
+		},
+		{
+			name:          "no header",
+			noCredentials: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", uri, strings.NewReader(body))
+			if tt.signature != "" {
+				r.Header.Set(SignatureHeader, tt.signature)
+			}
+			id, err := a.Authenticate(r)
+			if tt.noCredentials {
+				if !errors.Is(err, ErrNoCredentials) {
+					t.Errorf("Authenticate() = %v, %v, want ErrNoCredentials", id, err)
+				}
+				return
+			}
+			if tt.want == "" {
+				if err == nil || errors.Is(err, ErrNoCredentials) {
+					t.Errorf("Authenticate() = %v, %v, want an error", id, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id.Subject != tt.want || id.Method != "hmac" {
+				t.Errorf("Authenticate() = %+v, want subject %q with hmac", id, tt.want)
+			}
+		})
+	}
+}
+
+func TestHMACDefaultSkew(t *testing.T) {
+	a := NewHMAC(map[string][]byte{"a": []byte("secret")}, 0)
+	for _, tt := range []struct {
+		age time.Duration
+		ok  bool
+	}{
+		{age: DefaultMaxSkew - time.Minute, ok: true},
+		{age: DefaultMaxSkew + time.Minute},
+	} {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.Header.Set(SignatureHeader, signature("secret", "a", time.Now().Add(-tt.age), "GET", "/", ""))
+		if _, err := a.Authenticate(r); (err == nil) != tt.ok {
+			t.Errorf("signed %v ago: Authenticate() = %v, want ok = %v", tt.age, err, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// testJWKS returns a JWKS with the public keys by their IDs.
+func testJWKS(t *testing.T, keys map[string]crypto.PublicKey) []byte {
+	t.Helper()
+	var set struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	for kid, k := range keys {
+		switch k := k.(type) {
+		case *ecdsa.PublicKey:
+			set.Keys = append(set.Keys, map[string]string{
+				"kty": "EC", "kid": kid, "crv": "P-256",
+				"x": base64.RawURLEncoding.EncodeToString(k.X.FillBytes(make([]byte, 32))),
+				"y": base64.RawURLEncoding.EncodeToString(k.Y.FillBytes(make([]byte, 32))),
+			})
+		case ed25519.PublicKey:
+			set.Keys = append(set.Keys, map[string]string{
+				"kty": "OKP", "kid": kid, "crv": "Ed25519",
+				"x": base64.RawURLEncoding.EncodeToString(k),
+			})
+		default:
+			t.Fatalf("unsupported key %T", k)
+		}
+	}
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestJWT(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks := testJWKS(t, map[string]crypto.PublicKey{"ec": &ecKey.PublicKey, "ed": edPub})
+	a, err := NewJWTWithKeys(jwks, "https://idp", "dfanout")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	valid := func() jwt.RegisteredClaims {
+		return jwt.RegisteredClaims{
+			Subject:   "alice",
+			Issuer:    "https://idp",
+			Audience:  jwt.ClaimStrings{"dfanout"},
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		}
+	}
+	sign := func(method jwt.SigningMethod, kid string, key any, claims jwt.RegisteredClaims) string {
+		token := jwt.NewWithClaims(method, claims)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		s, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	with := func(f func(c *jwt.RegisteredClaims)) jwt.RegisteredClaims {
+		c := valid()
+		f(&c)
+		return c
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		want          string // subject, empty if rejected
+		noCredentials bool
+	}{
+		{
+			name:          "ES256",
+			authorization: "Bearer " + sign(jwt.SigningMethodES256, "ec", ecKey, valid()),
+			want:          "alice",
+		},
+		{
+			name:          "EdDSA",
+			authorization: "Bearer " + sign(jwt.SigningMethodEdDSA, "ed", edKey, valid()),
+			want:          "alice",
+		},
+		{
+			name:          "lowercase scheme",
+			authorization: "bearer " + sign(jwt.SigningMethodES256, "ec", ecKey, valid()),
+			want:          "alice",
+		},
+		{
+			name:          "signed by another key",
+			authorization: "Bearer " + sign(jwt.SigningMethodES256, "ec", otherKey, valid()),
+		},
+		{
+			name:          "unknown key",
+			authorization: "Bearer " + sign(jwt.SigningMethodES256, "other", otherKey, valid()),
+		},
+		{
+			name:          "no key ID with several keys",
+			authorization: "Bearer " + sign(jwt.SigningMethodES256, "", ecKey, valid()),
+		},
+		{
+			name:          "HMAC with the public key",
+			authorization: "Bearer " + sign(jwt.SigningMethodHS256, "ed", []byte(edPub), valid()),
+		},
+		{
+			name:          "none",
+			authorization: "Bearer " + sign(jwt.SigningMethodNone, "ec", jwt.UnsafeAllowNoneSignatureType, valid()),
+		},
+		{
+			name: "expired",
+			authorization: "Bearer " + sign(jwt.SigningMethodES256, "ec", ecKey, with(func(c *jwt.RegisteredClaims) {
+				c.ExpiresAt = jwt.NewNumericDate(now.Add(-time.Minute))
+			})),
+		},
+		{
+			name: "no expiry",
+			authorization: "Bearer " + sign(jwt.SigningMethodES256, "ec", ecKey, with(func(c *jwt.RegisteredClaims) {
+				c.ExpiresAt = nil
+			})),
+		},
+		{
+			name: "not valid yet",
+			authorization: "Bearer " + sign(jwt.SigningMethodES256, "ec", ecKey, with(func(c *jwt.RegisteredClaims) {
+				c.NotBefore = jwt.NewNumericDate(now.Add(time.Hour))
+			})),
+		},
+		{
+			name: "wrong audience",
+			authorization: "Bearer " + sign(jwt.SigningMethodES256, "ec", ecKey, with(func(c *jwt.RegisteredClaims) {
+				c.Audience = jwt.ClaimStrings{"other"}
+			})),
+		},
+		{
+			name: "one of the audiences",
+			authorization: "Bearer " + sign(jwt.SigningMethodES256, "ec", ecKey, with(func(c *jwt.RegisteredClaims) {
+				c.Audience = jwt.ClaimStrings{"other", "dfanout"}
+			})),
+			want: "alice",
+		},
+		{
+			name: "no audience",
+			authorization: "Bearer " + sign(jwt.SigningMethodES256, "ec", ecKey, with(func(c *jwt.RegisteredClaims) {
+				c.Audience = nil
+			})),
+		},
+		{
+			name: "wrong issuer",
+			authorization: "Bearer " + sign(jwt.SigningMethodES256, "ec", ecKey, with(func(c *jwt.RegisteredClaims) {
+				c.Issuer = "https://evil"
+			})),
+		},
+		{
+			name: "no subject",
+			authorization: "Bearer " + sign(jwt.SigningMethodES256, "ec", ecKey, with(func(c *jwt.RegisteredClaims) {
+				c.Subject = ""
+			})),
+		},
+		{
+			name:          "tampered",
+			authorization: "Bearer " + sign(jwt.SigningMethodES256, "ec", ecKey, valid()) + "A",
+		},
+		{
+			name:          "no token",
+			noCredentials: true,
+		},
+		{
+			name:          "static token",
+			authorization: "Bearer 0123456789",
+			noCredentials: true,
+		},
+		{
+			name:          "basic",
+			authorization: "Basic " + sign(jwt.SigningMethodES256, "ec", ecKey, valid()),
+			noCredentials: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+			id, err := a.Authenticate(r)
+			if tt.noCredentials {
+				if !errors.Is(err, ErrNoCredentials) {
+					t.Errorf("Authenticate() = %v, %v, want ErrNoCredentials", id, err)
+				}
+				return
+			}
+			if tt.want == "" {
+				if err == nil || errors.Is(err, ErrNoCredentials) {
+					t.Errorf("Authenticate() = %v, %v, want an invalid JWT error", id, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id.Subject != tt.want || id.Method != "jwt" {
+				t.Errorf("Authenticate() = %+v, want subject %q with jwt", id, tt.want)
+			}
+		})
+	}
+}
+
+func TestJWTSingleKey(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Issuer and audience aren't checked if they aren't configured.
+	a, err := NewJWTWithKeys(testJWKS(t, map[string]crypto.PublicKey{"ed": pub}), "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.RegisteredClaims{
+		Subject:   "bob",
+		Issuer:    "https://any",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	id, err := a.Authenticate(r)
+	if err != nil {
+		t.Fatalf("Authenticate() of a token without a key ID = %v", err)
+	}
+	if id.Subject != "bob" {
+		t.Errorf("subject = %q, want bob", id.Subject)
+	}
+}
+
+func TestParseJWKS(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := base64.RawURLEncoding.EncodeToString(pub)
+	tests := []struct {
+		name string
+		jwks string
+		keys int // 0 if invalid
+	}{
+		{name: "ed25519", jwks: `{"keys":[{"kty":"OKP","kid":"a","crv":"Ed25519","x":"` + x + `"}]}`, keys: 1},
+		{name: "encryption keys are skipped", jwks: `{"keys":[{"kty":"OKP","kid":"a","crv":"Ed25519","x":"` + x + `"},{"kty":"OKP","kid":"b","use":"enc","crv":"X25519","x":"` + x + `"}]}`, keys: 1},
+		{name: "only encryption keys", jwks: `{"keys":[{"kty":"OKP","kid":"b","use":"enc","crv":"Ed25519","x":"` + x + `"}]}`},
+		{name: "no keys", jwks: `{"keys":[]}`},
+		{name: "unsupported key type", jwks: `{"keys":[{"kty":"oct","kid":"a","k":"c2VjcmV0"}]}`},
+		{name: "unsupported curve", jwks: `{"keys":[{"kty":"EC","kid":"a","crv":"P-192","x":"AQ","y":"AQ"}]}`},
+		{name: "point off the curve", jwks: `{"keys":[{"kty":"EC","kid":"a","crv":"P-256","x":"AQ","y":"AQ"}]}`},
+		{name: "short ed25519 key", jwks: `{"keys":[{"kty":"OKP","kid":"a","crv":"Ed25519","x":"AQ"}]}`},
+		{name: "missing RSA modulus", jwks: `{"keys":[{"kty":"RSA","kid":"a","e":"AQAB"}]}`},
+		{name: "invalid JSON", jwks: `{`},
+	}
+	for _, tt := range tests {
+		keys, err := parseJWKS([]byte(tt.jwks))
+		if tt.keys == 0 {
+			if err == nil {
+				t.Errorf("%s: parseJWKS() = %d keys, want an error", tt.name, len(keys))
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: parseJWKS() = %v", tt.name, err)
+			continue
+		}
+		if len(keys) != tt.keys {
+			t.Errorf("%s: parseJWKS() = %d keys, want %d", tt.name, len(keys), tt.keys)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+// MTLS authenticates the requests with verified client certificates.
+// The subject is the certificate's first URI SAN, e.g. a SPIFFE ID,
+// or its common name if it has no URI SANs.
+type MTLS struct {
+	// Allowed is the subjects allowed to authenticate.
+	// If empty, all verified certificates are allowed.
+	Allowed []string
+}
+
+func (m MTLS) Authenticate(r *http.Request) (*Identity, error) {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return nil, ErrNoCredentials
+	}
+	if len(r.TLS.VerifiedChains) == 0 {
+		return nil, errors.New("client certificate isn't verified")
+	}
+	cert := r.TLS.VerifiedChains[0][0]
+	subject := cert.Subject.CommonName
+	if len(cert.URIs) > 0 {
+		subject = cert.URIs[0].String()
+	}
+	if subject == "" {
+		return nil, errors.New("client certificate has no identity")
+	}
+	if len(m.Allowed) > 0 && !slices.Contains(m.Allowed, subject) {
+		return nil, fmt.Errorf("client certificate of %q isn't allowed", subject)
+	}
+	return &Identity{Subject: subject, Method: "mtls"}, nil
+}
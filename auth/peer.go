@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// LoadPeerToken reads the token the peers of a cluster
+// share from the file, ignoring surrounding whitespace.
+func LoadPeerToken(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", errors.New("peer token file is empty")
+	}
+	return token, nil
+}
+
+// PeerMiddleware responds with 401 to the requests that don't
+// have the peer token as their bearer token. It protects the
+// routes the peers of a cluster serve each other.
+func PeerMiddleware(token string, next http.Handler) http.Handler {
+	want := sha256.Sum256([]byte(token))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got := sha256.Sum256([]byte(bearerToken(r)))
+		if subtle.ConstantTimeCompare(got[:], want[:]) != 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// PeerTransport returns a transport that sends the peer token as
+// the bearer token of the requests. If base is nil, it sends them
+// with http.DefaultTransport.
+func PeerTransport(token string, base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &peerTransport{token: token, base: base}
+}
+
+type peerTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+func (t *peerTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", "Bearer "+t.token)
+	return t.base.RoundTrip(r)
+}
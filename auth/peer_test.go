@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPeerMiddleware(t *testing.T) {
+	h := PeerMiddleware("secret", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	tests := []struct {
+		authorization string
+		want          int
+	}{
+		{authorization: "", want: http.StatusUnauthorized},
+		{authorization: "Bearer wrong", want: http.StatusUnauthorized},
+		{authorization: "Basic secret", want: http.StatusUnauthorized},
+		{authorization: "Bearer secret", want: http.StatusOK},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest("GET", "/_groupcache/x", nil)
+		if tt.authorization != "" {
+			r.Header.Set("Authorization", tt.authorization)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		if w.Code != tt.want {
+			t.Errorf("Authorization %q: status = %d, want %d", tt.authorization, w.Code, tt.want)
+		}
+	}
+}
+
+func TestPeerTransport(t *testing.T) {
+	s := httptest.NewServer(PeerMiddleware("secret", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})))
+	defer s.Close()
+	client := &http.Client{Transport: PeerTransport("secret", nil)}
+	resp, err := client.Get(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+}
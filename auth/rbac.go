@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Role is a set of permissions on fanouts. Each role
+// has the permissions of the roles before it.
+type Role int
+
+const (
+	// Viewer can read fanouts, mismatches and dead letters.
+	Viewer Role = iota + 1
+
+	// Editor can also create and update fanouts,
+	// and replay dead letters.
+	Editor
+
+	// Admin can also delete fanouts and purge dead letters.
+	Admin
+)
+
+var roleNames = map[Role]string{
+	Viewer: "viewer",
+	Editor: "editor",
+	Admin:  "admin",
+}
+
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("Role(%d)", int(r))
+}
+
+// ParseRole parses a role's name.
+func ParseRole(name string) (Role, error) {
+	for r, n := range roleNames {
+		if n == name {
+			return r, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown role %q", name)
+}
+
+func (r *Role) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	role, err := ParseRole(name)
+	if err != nil {
+		return err
+	}
+	*r = role
+	return nil
+}
+
+func (r Role) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+// Binding grants a role on the fanouts whose names
+// start with a prefix to a subject.
+type Binding struct {
+	// Subject is the identity the role is granted to,
+	// or "*" for all authenticated callers.
+	Subject string `json:"subject"`
+
+	Role Role `json:"role"`
+
+	// FanoutPrefix limits the role to the fanouts whose names
+	// start with the prefix. If empty, it applies to all fanouts.
+	// It also limits the secrets of the secrets table the fanouts
+	// can reference to the ones whose names start with the prefix.
+	FanoutPrefix string `json:"fanout_prefix,omitempty"`
+}
+
+// Policy authorizes the subjects with their bindings.
+type Policy struct {
+	bindings []Binding
+}
+
+// NewPolicy returns a policy with the bindings.
+func NewPolicy(bindings []Binding) (*Policy, error) {
+	for _, b := range bindings {
+		if b.Subject == "" {
+			return nil, fmt.Errorf("binding of role %v on %q has no subject", b.Role, b.FanoutPrefix)
+		}
+		if _, ok := roleNames[b.Role]; !ok {
+			return nil, fmt.Errorf("binding of %q has no role", b.Subject)
+		}
+	}
+	return &Policy{bindings: bindings}, nil
+}
+
+// Allowed reports whether the subject has the role, or a role with
+// more permissions, on the fanout. An empty fanout stands for all
+// fanouts, and requires a binding without a prefix.
+func (p *Policy) Allowed(subject string, role Role, fanout string) bool {
+	for _, b := range p.bindings {
+		if b.Subject != subject && b.Subject != "*" {
+			continue
+		}
+		if b.Role < role {
+			continue
+		}
+		if b.FanoutPrefix == "" || (fanout != "" && strings.HasPrefix(fanout, b.FanoutPrefix)) {
+			return true
+		}
+	}
+	return false
+}
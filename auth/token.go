@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Token is a static API token.
+type Token struct {
+	// Subject is the name the token authenticates as.
+	Subject string `json:"subject"`
+
+	// SHA256 is the hex encoded SHA-256 hash of the token,
+	// so the tokens aren't stored in the config.
+	SHA256 string `json:"sha256"`
+}
+
+// Tokens authenticates the requests with static API tokens,
+// sent as bearer tokens or in a header.
+type Tokens struct {
+	header string
+	hashes map[[sha256.Size]byte]string // hash -> subject
+}
+
+// NewTokens returns an authenticator for the tokens
+// sent as bearer tokens.
+func NewTokens(tokens []Token) (*Tokens, error) {
+	return NewHeaderTokens("", tokens)
+}
+
+// NewHeaderTokens returns an authenticator for the tokens sent in
+// the header. If the header is empty, tokens are bearer tokens.
+func NewHeaderTokens(header string, tokens []Token) (*Tokens, error) {
+	t := &Tokens{header: header, hashes: make(map[[sha256.Size]byte]string, len(tokens))}
+	for _, token := range tokens {
+		if token.Subject == "" {
+			return nil, fmt.Errorf("token with hash %q has no subject", token.SHA256)
+		}
+		b, err := hex.DecodeString(token.SHA256)
+		if err != nil || len(b) != sha256.Size {
+			return nil, fmt.Errorf("token of %q has an invalid SHA-256 hash", token.Subject)
+		}
+		t.hashes[[sha256.Size]byte(b)] = token.Subject
+	}
+	return t, nil
+}
+
+func (t *Tokens) Authenticate(r *http.Request) (*Identity, error) {
+	token := bearerToken(r)
+	if t.header != "" {
+		token = r.Header.Get(t.header)
+	}
+	if token == "" {
+		return nil, ErrNoCredentials
+	}
+	sum := sha256.Sum256([]byte(token))
+	// Looking up the hash doesn't leak the
+	// tokens through the time it takes.
+	if subject, ok := t.hashes[sum]; ok {
+		method := "token"
+		if t.header != "" {
+			method = "api_key"
+		}
+		return &Identity{Subject: subject, Method: method}, nil
+	}
+	if t.header != "" {
+		return nil, errors.New("unknown API key")
+	}
+	// May be a JWT.
+	return nil, ErrNoCredentials
+}
@@ -0,0 +1,168 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestTokens(t *testing.T) {
+	tokens := []Token{
+		{Subject: "deployer", SHA256: hash("deploy-token")},
+		{Subject: "reader", SHA256: hash("read-token")},
+	}
+	bearer, err := NewTokens(tokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apiKeys, err := NewHeaderTokens("X-API-Key", tokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name          string
+		authenticator *Tokens
+		header        http.Header
+		want          string // subject, empty if rejected
+		method        string
+		noCredentials bool
+	}{
+		{
+			name:          "bearer",
+			authenticator: bearer,
+			header:        http.Header{"Authorization": {"Bearer deploy-token"}},
+			want:          "deployer",
+			method:        "token",
+		},
+		{
+			name:          "another bearer",
+			authenticator: bearer,
+			header:        http.Header{"Authorization": {"Bearer read-token"}},
+			want:          "reader",
+			method:        "token",
+		},
+		{
+			// It may be a JWT for the next authenticator.
+			name:          "unknown bearer",
+			authenticator: bearer,
+			header:        http.Header{"Authorization": {"Bearer other-token"}},
+			noCredentials: true,
+		},
+		{
+			name:          "no bearer",
+			authenticator: bearer,
+			header:        http.Header{"X-Api-Key": {"deploy-token"}},
+			noCredentials: true,
+		},
+		{
+			name:          "API key",
+			authenticator: apiKeys,
+			header:        http.Header{"X-Api-Key": {"deploy-token"}},
+			want:          "deployer",
+			method:        "api_key",
+		},
+		{
+			name:          "unknown API key",
+			authenticator: apiKeys,
+			header:        http.Header{"X-Api-Key": {"other-token"}},
+		},
+		{
+			name:          "API key prefix",
+			authenticator: apiKeys,
+			header:        http.Header{"X-Api-Key": {"deploy-toke"}},
+		},
+		{
+			name:          "API key as a bearer",
+			authenticator: apiKeys,
+			header:        http.Header{"Authorization": {"Bearer deploy-token"}},
+			noCredentials: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.Header = tt.header
+			id, err := tt.authenticator.Authenticate(r)
+			if tt.noCredentials {
+				if !errors.Is(err, ErrNoCredentials) {
+					t.Errorf("Authenticate() = %v, %v, want ErrNoCredentials", id, err)
+				}
+				return
+			}
+			if tt.want == "" {
+				if err == nil || errors.Is(err, ErrNoCredentials) {
+					t.Errorf("Authenticate() = %v, %v, want an error", id, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id.Subject != tt.want || id.Method != tt.method {
+				t.Errorf("Authenticate() = %+v, want %q with %s", id, tt.want, tt.method)
+			}
+		})
+	}
+}
+
+func TestNewTokens(t *testing.T) {
+	tests := []struct {
+		name  string
+		token Token
+	}{
+		{name: "no subject", token: Token{SHA256: hash("token")}},
+		{name: "invalid hex", token: Token{Subject: "a", SHA256: "zz"}},
+		{name: "short hash", token: Token{Subject: "a", SHA256: hash("token")[:32]}},
+	}
+	for _, tt := range tests {
+		if _, err := NewTokens([]Token{tt.token}); err == nil {
+			t.Errorf("%s: NewTokens() = nil, want an error", tt.name)
+		}
+	}
+}
+
+func TestChain(t *testing.T) {
+	tokens, err := NewTokens([]Token{{Subject: "deployer", SHA256: hash("deploy-token")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	apiKeys, err := NewHeaderTokens("X-API-Key", []Token{{Subject: "reader", SHA256: hash("read-token")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain := Chain{tokens, apiKeys}
+	tests := []struct {
+		name   string
+		header http.Header
+		want   string
+		err    bool
+	}{
+		{name: "first", header: http.Header{"Authorization": {"Bearer deploy-token"}}, want: "deployer"},
+		{name: "second", header: http.Header{"X-Api-Key": {"read-token"}}, want: "reader"},
+		{name: "first match", header: http.Header{"Authorization": {"Bearer deploy-token"}, "X-Api-Key": {"wrong"}}, want: "deployer"},
+		{name: "invalid", header: http.Header{"X-Api-Key": {"wrong"}}, err: true},
+		{name: "none", header: http.Header{}, err: true},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.Header = tt.header
+		id, err := chain.Authenticate(r)
+		if tt.err {
+			if err == nil {
+				t.Errorf("%s: Authenticate() = %+v, want an error", tt.name, id)
+			}
+			continue
+		}
+		if err != nil || id.Subject != tt.want {
+			t.Errorf("%s: Authenticate() = %+v, %v, want %q", tt.name, id, err, tt.want)
+		}
+	}
+}
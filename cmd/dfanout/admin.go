@@ -2,11 +2,26 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
 
+	"github.com/dfanout/dfanout/auth"
+	"github.com/dfanout/dfanout/fanout/compare"
+	"github.com/dfanout/dfanout/fanout/outbox"
+	"github.com/dfanout/dfanout/fanout/transform"
+	"github.com/dfanout/dfanout/fanout/urltemplate"
 	pb "github.com/dfanout/dfanout/proto"
+	"github.com/dfanout/dfanout/secrets"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/proto"
 )
 
 const maxEndpoints = 10
@@ -14,12 +29,24 @@ const maxEndpoints = 10
 var protoMarshaler = &jsonpb.Marshaler{EnumsAsInts: true, EmitDefaults: true, OrigName: true}
 
 type adminService struct {
-	pgConn *pgx.Conn
+	pool       *pgxpool.Pool
+	mismatches *compare.DB
+	outbox     *outbox.Outbox
+	secrets    *secrets.Store
 }
 
+// GetFanout returns the fanout with its secret material redacted.
 func (s *adminService) GetFanout(ctx context.Context, req *pb.GetFanoutRequest) (*pb.GetFanoutResponse, error) {
-	rows, err := s.pgConn.Query(ctx,
-		`SELECT endpoint_name, is_primary, http_endpoint
+	resp, err := s.loadFanout(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return redactFanout(resp), nil
+}
+
+func (s *adminService) loadFanout(ctx context.Context, req *pb.GetFanoutRequest) (*pb.GetFanoutResponse, error) {
+	rows, err := s.pool.Query(ctx,
+		`SELECT endpoint_name, is_primary, config, http_endpoint, grpc_endpoint, twirp_endpoint, fanout_endpoint
 		 FROM endpoints
 		 WHERE fanout_name = $1
 		 ORDER BY is_primary DESC`, req.FanName)
@@ -30,35 +57,80 @@ func (s *adminService) GetFanout(ctx context.Context, req *pb.GetFanoutRequest)
 
 	var endpoints []*pb.Endpoint
 	var (
-		endpointName string
-		primary      bool
-		httpEndpoint string
+		endpointName   string
+		primary        bool
+		config         *string
+		httpEndpoint   *string
+		grpcEndpoint   *string
+		twirpEndpoint  *string
+		fanoutEndpoint *string
 	)
 	for rows.Next() {
-		if err := rows.Scan(&endpointName, &primary, &httpEndpoint); err != nil {
+		if err := rows.Scan(&endpointName, &primary, &config, &httpEndpoint, &grpcEndpoint, &twirpEndpoint, &fanoutEndpoint); err != nil {
 			return nil, err
 		}
-		var endpoint pb.HTTPEndpoint
-		if err := jsonpb.UnmarshalString(httpEndpoint, &endpoint); err != nil {
-			return nil, err
+		endpoint := &pb.Endpoint{}
+		if config != nil {
+			if err := jsonpb.UnmarshalString(*config, endpoint); err != nil {
+				return nil, err
+			}
+		}
+		endpoint.Name = endpointName
+		endpoint.Primary = primary
+		switch {
+		case httpEndpoint != nil:
+			var d pb.HTTPEndpoint
+			if err := jsonpb.UnmarshalString(*httpEndpoint, &d); err != nil {
+				return nil, err
+			}
+			endpoint.Destination = &pb.Endpoint_HttpEndpoint{HttpEndpoint: &d}
+		case grpcEndpoint != nil:
+			var d pb.GRPCEndpoint
+			if err := jsonpb.UnmarshalString(*grpcEndpoint, &d); err != nil {
+				return nil, err
+			}
+			endpoint.Destination = &pb.Endpoint_GrpcEndpoint{GrpcEndpoint: &d}
+		case twirpEndpoint != nil:
+			var d pb.TwirpEndpoint
+			if err := jsonpb.UnmarshalString(*twirpEndpoint, &d); err != nil {
+				return nil, err
+			}
+			endpoint.Destination = &pb.Endpoint_TwirpEndpoint{TwirpEndpoint: &d}
+		case fanoutEndpoint != nil:
+			var d pb.FanoutEndpoint
+			if err := jsonpb.UnmarshalString(*fanoutEndpoint, &d); err != nil {
+				return nil, err
+			}
+			endpoint.Destination = &pb.Endpoint_FanoutEndpoint{FanoutEndpoint: &d}
+		default:
+			return nil, fmt.Errorf("endpoint %q has no destination", endpointName)
 		}
-		endpoints = append(endpoints, &pb.Endpoint{
-			Name:    endpointName,
-			Primary: primary,
-			Destination: &pb.Endpoint_HttpEndpoint{
-				HttpEndpoint: &endpoint,
-			},
-		})
+		endpoints = append(endpoints, endpoint)
 	}
-	return &pb.GetFanoutResponse{Endpoints: endpoints}, nil
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	fanoutConfig, err := s.fanoutConfig(ctx, req.FanName)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetFanoutResponse{Endpoints: endpoints, Config: fanoutConfig}, nil
 }
 
 func (s *adminService) CreateFanout(ctx context.Context, req *pb.CreateFanoutRequest) (resp *pb.CreateFanoutResponse, err error) {
 	if n := len(req.Endpoints); n > maxEndpoints {
 		return nil, fmt.Errorf("a maximum of 10 endpoints are allowed, %d provided", n)
 	}
+	if err := s.checkSecretRefs(req); err != nil {
+		return nil, err
+	}
 
-	tx, err := s.pgConn.BeginTx(ctx, pgx.TxOptions{})
+	// Validating the fanout graph reads the other fanouts,
+	// use serializable transactions to avoid racing updates.
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +140,9 @@ func (s *adminService) CreateFanout(ctx context.Context, req *pb.CreateFanoutReq
 		}
 	}()
 
+	if err := s.insertFanoutConfig(ctx, tx, req.FanoutName, req.Config); err != nil {
+		return nil, err
+	}
 	for _, e := range req.Endpoints {
 		if err := s.insertEndpoint(ctx, tx, req.FanoutName, e); err != nil {
 			return nil, err
@@ -76,14 +151,23 @@ func (s *adminService) CreateFanout(ctx context.Context, req *pb.CreateFanoutReq
 	if err := s.validatePrimaryCount(ctx, tx, req.FanoutName); err != nil {
 		return nil, err
 	}
+	if err := s.validateFanoutConfig(ctx, tx, req.FanoutName); err != nil {
+		return nil, err
+	}
+	if err := s.validateFanoutGraph(ctx, tx); err != nil {
+		return nil, err
+	}
 	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
 	return &pb.CreateFanoutResponse{}, nil
 }
 
-func (s *adminService) UpdateFanout(ctx context.Context, req *pb.UpdateFanoutRequest) (*pb.UpdateFanoutRequest, error) {
-	tx, err := s.pgConn.BeginTx(ctx, pgx.TxOptions{
+func (s *adminService) UpdateFanout(ctx context.Context, req *pb.UpdateFanoutRequest) (resp *pb.UpdateFanoutRequest, err error) {
+	if err := s.checkSecretRefs(req); err != nil {
+		return nil, err
+	}
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{
 		IsoLevel: pgx.Serializable,
 	})
 	if err != nil {
@@ -112,59 +196,707 @@ func (s *adminService) UpdateFanout(ctx context.Context, req *pb.UpdateFanoutReq
 			return nil, err
 		}
 	}
+	if req.Config != nil {
+		if err := s.upsertFanoutConfig(ctx, tx, req.FanoutName, req.Config); err != nil {
+			return nil, err
+		}
+	}
 
 	if err := s.validatePrimaryCount(ctx, tx, req.FanoutName); err != nil {
 		return nil, err
 	}
+	if err := s.validateFanoutConfig(ctx, tx, req.FanoutName); err != nil {
+		return nil, err
+	}
+	if err := s.validateFanoutGraph(ctx, tx); err != nil {
+		return nil, err
+	}
 	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
-	// TODO: Implement update and insert. Check primary count.
 	return &pb.UpdateFanoutRequest{}, nil
 }
 
-func (s *adminService) DeleteFanout(ctx context.Context, req *pb.DeleteFanoutRequest) (*pb.DeleteFanoutResponse, error) {
-	_, err := s.pgConn.Exec(ctx,
+func (s *adminService) DeleteFanout(ctx context.Context, req *pb.DeleteFanoutRequest) (resp *pb.DeleteFanoutResponse, err error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel: pgx.Serializable,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	_, err = tx.Exec(ctx,
 		`DELETE FROM endpoints WHERE fanout_name = $1`, req.FanoutName)
 	if err != nil {
 		return nil, err
 	}
+	_, err = tx.Exec(ctx,
+		`DELETE FROM fanouts WHERE fanout_name = $1`, req.FanoutName)
+	if err != nil {
+		return nil, err
+	}
+	// The nodes may still add the results they haven't flushed yet.
+	_, err = tx.Exec(ctx,
+		`DELETE FROM mismatches WHERE fanout_name = $1`, req.FanoutName)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(ctx,
+		`DELETE FROM mismatch_samples WHERE fanout_name = $1`, req.FanoutName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateFanoutGraph(ctx, tx); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
 	return &pb.DeleteFanoutResponse{}, nil
 }
 
-func (s *adminService) insertEndpoint(ctx context.Context, tx pgx.Tx, fanout string, e *pb.Endpoint) error {
-	switch endpoint := e.Destination.(type) {
-	case *pb.Endpoint_HttpEndpoint:
-		// TODO: Validate the endpoint.
-		httpEndpoint, err := protoMarshaler.MarshalToString(endpoint.HttpEndpoint)
+func (s *adminService) GetMismatches(ctx context.Context, req *pb.GetMismatchesRequest) (*pb.GetMismatchesResponse, error) {
+	reports, err := s.mismatches.Report(ctx, req.FanoutName, req.EndpointName)
+	if err != nil {
+		return nil, err
+	}
+	var endpoints []*pb.EndpointMismatches
+	for _, r := range reports {
+		e := &pb.EndpointMismatches{
+			EndpointName: r.Endpoint,
+			Compared:     r.Compared,
+			Mismatched:   r.Mismatched,
+		}
+		for _, m := range r.Samples {
+			mismatch := &pb.Mismatch{TimeUnixMs: m.Time.UnixMilli()}
+			for _, d := range m.Differences {
+				mismatch.Differences = append(mismatch.Differences, &pb.Difference{
+					Path:      d.Path,
+					Primary:   d.Primary,
+					Secondary: d.Secondary,
+				})
+			}
+			e.Samples = append(e.Samples, mismatch)
+		}
+		endpoints = append(endpoints, e)
+	}
+	return &pb.GetMismatchesResponse{Endpoints: endpoints}, nil
+}
+
+func (s *adminService) SetEndpointSampling(ctx context.Context, req *pb.SetEndpointSamplingRequest) (resp *pb.SetEndpointSamplingResponse, err error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
 		if err != nil {
-			return err
+			tx.Rollback(ctx)
+		}
+	}()
+
+	row := tx.QueryRow(ctx,
+		`SELECT is_primary, config FROM endpoints
+		 WHERE fanout_name = $1 AND endpoint_name = $2
+		 FOR UPDATE`, req.FanoutName, req.EndpointName)
+	var (
+		primary bool
+		config  *string
+	)
+	if err := row.Scan(&primary, &config); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("endpoint %q not found in %q", req.EndpointName, req.FanoutName)
+		}
+		return nil, err
+	}
+	endpoint := &pb.Endpoint{}
+	if config != nil {
+		if err := jsonpb.UnmarshalString(*config, endpoint); err != nil {
+			return nil, err
+		}
+	}
+	endpoint.Name = req.EndpointName
+	endpoint.Primary = primary
+	endpoint.Sampling = req.Sampling
+
+	// The rest of the endpoint was validated when it was written,
+	// against its destination which isn't loaded here.
+	if err := validateSampling(endpoint); err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: %w", endpoint.Name, err)
+	}
+	data, err := encodeEndpointConfig(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(ctx,
+		`UPDATE endpoints SET config = $1, updated_at = NOW()
+		 WHERE fanout_name = $2 AND endpoint_name = $3`, data, req.FanoutName, req.EndpointName)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.SetEndpointSamplingResponse{}, nil
+}
+
+func (s *adminService) SetRateLimit(ctx context.Context, req *pb.SetRateLimitRequest) (resp *pb.SetRateLimitResponse, err error) {
+	if err := validateRateLimit(req.RateLimit); err != nil {
+		return nil, err
+	}
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	var endpoints int
+	if err := tx.QueryRow(ctx,
+		`SELECT COUNT(*) FROM endpoints WHERE fanout_name = $1`, req.FanoutName).Scan(&endpoints); err != nil {
+		return nil, err
+	}
+	if endpoints == 0 {
+		return nil, fmt.Errorf("fanout %q not found", req.FanoutName)
+	}
+	var (
+		config pb.FanoutConfig
+		data   *string
+	)
+	err = tx.QueryRow(ctx,
+		`SELECT config FROM fanouts WHERE fanout_name = $1 FOR UPDATE`, req.FanoutName).Scan(&data)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+	if data != nil {
+		if err := jsonpb.UnmarshalString(*data, &config); err != nil {
+			return nil, err
+		}
+	}
+	config.RateLimit = req.RateLimit
+	if err := s.upsertFanoutConfig(ctx, tx, req.FanoutName, &config); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.SetRateLimitResponse{}, nil
+}
+
+const (
+	defaultDeadLettersLimit = 100
+	maxDeadLettersLimit     = 1000
+)
+
+func (s *adminService) ListDeadLetters(ctx context.Context, req *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error) {
+	if req.FanoutName == "" {
+		return nil, twirp.RequiredArgumentError("fanout_name")
+	}
+	limit := int(req.Limit)
+	switch {
+	case limit < 0 || limit > maxDeadLettersLimit:
+		return nil, twirp.InvalidArgumentError("limit", fmt.Sprintf("should be between 0 and %d", maxDeadLettersLimit))
+	case limit == 0:
+		limit = defaultDeadLettersLimit
+	}
+	messages, err := s.outbox.List(ctx, req.FanoutName, req.EndpointName, req.AfterId, limit)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListDeadLettersResponse{}
+	for _, m := range messages {
+		resp.DeadLetters = append(resp.DeadLetters, deadLetter(m))
+	}
+	return resp, nil
+}
+
+func (s *adminService) GetDeadLetter(ctx context.Context, req *pb.GetDeadLetterRequest) (*pb.GetDeadLetterResponse, error) {
+	m, err := s.outbox.Get(ctx, req.Id)
+	if errors.Is(err, outbox.ErrNotFound) || (err == nil && m.State != outbox.StateDead) {
+		return nil, twirp.NotFoundError(fmt.Sprintf("dead letter %d not found", req.Id))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetDeadLetterResponse{DeadLetter: deadLetter(m)}, nil
+}
+
+func (s *adminService) ReplayDeadLetters(ctx context.Context, req *pb.ReplayDeadLettersRequest) (*pb.ReplayDeadLettersResponse, error) {
+	if req.FanoutName == "" {
+		return nil, twirp.RequiredArgumentError("fanout_name")
+	}
+	n, err := s.outbox.Replay(ctx, req.FanoutName, req.EndpointName, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReplayDeadLettersResponse{Replayed: n}, nil
+}
+
+func (s *adminService) PurgeDeadLetters(ctx context.Context, req *pb.PurgeDeadLettersRequest) (*pb.PurgeDeadLettersResponse, error) {
+	if req.FanoutName == "" {
+		return nil, twirp.RequiredArgumentError("fanout_name")
+	}
+	n, err := s.outbox.Purge(ctx, req.FanoutName, req.EndpointName, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PurgeDeadLettersResponse{Purged: n}, nil
+}
+
+func deadLetter(m *outbox.Message) *pb.DeadLetter {
+	d := &pb.DeadLetter{
+		Id:              m.ID,
+		FanoutName:      m.Fanout,
+		EndpointName:    m.Endpoint,
+		Method:          m.Method,
+		Url:             m.URL,
+		Body:            m.Body,
+		Attempts:        int32(m.Attempts),
+		LastError:       m.LastError,
+		CreatedAtUnixMs: m.CreatedAt.UnixMilli(),
+		UpdatedAtUnixMs: m.UpdatedAt.UnixMilli(),
+	}
+	keys := make([]string, 0, len(m.Header))
+	for k := range m.Header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		d.Headers = append(d.Headers, &pb.Header{Key: k, Values: m.Header[k]})
+	}
+	return d
+}
+
+func (s *adminService) fanoutConfig(ctx context.Context, fanout string) (*pb.FanoutConfig, error) {
+	row := s.pool.QueryRow(ctx,
+		`SELECT config FROM fanouts WHERE fanout_name = $1`, fanout)
+
+	var config pb.FanoutConfig
+	var data string
+	if err := row.Scan(&data); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Fanouts created before fanout configs existed
+			// use the default config.
+			return &config, nil
 		}
-		_, err = tx.Exec(ctx,
-			`INSERT INTO endpoints (fanout_name, endpoint_name, is_primary, http_endpoint, created_at, updated_at)
-			 VALUES ($1, $2, $3, $4, NOW(), NOW())`, fanout, e.Name, e.Primary, httpEndpoint)
+		return nil, err
+	}
+	if err := jsonpb.UnmarshalString(data, &config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// uniqueViolation is the Postgres error code of unique constraint violations.
+const uniqueViolation = "23505"
+
+// insertFanoutConfig creates the fanout, failing
+// with AlreadyExists if there is a fanout with the name.
+func (s *adminService) insertFanoutConfig(ctx context.Context, tx pgx.Tx, fanout string, c *pb.FanoutConfig) error {
+	config, err := marshalFanoutConfig(c)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO fanouts (fanout_name, config, created_at, updated_at)
+		 VALUES ($1, $2, NOW(), NOW())`, fanout, config)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return twirp.NewError(twirp.AlreadyExists, fmt.Sprintf("fanout %q already exists", fanout))
+	}
+	return err
+}
+
+func (s *adminService) upsertFanoutConfig(ctx context.Context, tx pgx.Tx, fanout string, c *pb.FanoutConfig) error {
+	config, err := marshalFanoutConfig(c)
+	if err != nil {
 		return err
-	default:
-		panic("not supported endpoint")
 	}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO fanouts (fanout_name, config, created_at, updated_at)
+		 VALUES ($1, $2, NOW(), NOW())
+		 ON CONFLICT (fanout_name) DO UPDATE SET config = $2, updated_at = NOW()`, fanout, config)
+	return err
+}
+
+// marshalFanoutConfig encodes the fanout config, empty if nil, to JSON.
+func marshalFanoutConfig(c *pb.FanoutConfig) (string, error) {
+	if c == nil {
+		c = &pb.FanoutConfig{}
+	}
+	return protoMarshaler.MarshalToString(c)
+}
+
+func (s *adminService) insertEndpoint(ctx context.Context, tx pgx.Tx, fanout string, e *pb.Endpoint) error {
+	config, err := marshalEndpointConfig(e)
+	if err != nil {
+		return err
+	}
+	d, err := marshalDestination(e)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO endpoints (fanout_name, endpoint_name, is_primary, config, http_endpoint, grpc_endpoint, twirp_endpoint, fanout_endpoint, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())`, fanout, e.Name, e.Primary, config, d.httpEndpoint, d.grpcEndpoint, d.twirpEndpoint, d.fanoutEndpoint)
+	return err
 }
 
 func (s *adminService) updateEndpoint(ctx context.Context, tx pgx.Tx, fanout string, e *pb.Endpoint) error {
+	config, err := marshalEndpointConfig(e)
+	if err != nil {
+		return err
+	}
+	d, err := marshalDestination(e)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx,
+		`UPDATE endpoints
+		 SET is_primary = $1, config = $2, http_endpoint = $3, grpc_endpoint = $4, twirp_endpoint = $5, fanout_endpoint = $6, updated_at = NOW()
+		 WHERE fanout_name = $7 AND endpoint_name = $8`, e.Primary, config, d.httpEndpoint, d.grpcEndpoint, d.twirpEndpoint, d.fanoutEndpoint, fanout, e.Name)
+	return err
+}
+
+// marshalEndpointConfig encodes the endpoint's options that don't
+// have their own columns, e.g. the retry policy, to JSON.
+func marshalEndpointConfig(e *pb.Endpoint) (string, error) {
+	if err := validateRetryPolicy(e.RetryPolicy); err != nil {
+		return "", fmt.Errorf("invalid endpoint %q: %w", e.Name, err)
+	}
+	if err := validateSampling(e); err != nil {
+		return "", fmt.Errorf("invalid endpoint %q: %w", e.Name, err)
+	}
+	if err := validateMatchRules(e); err != nil {
+		return "", fmt.Errorf("invalid endpoint %q: %w", e.Name, err)
+	}
+	if err := validateTransform(e); err != nil {
+		return "", fmt.Errorf("invalid endpoint %q: %w", e.Name, err)
+	}
+	if err := validateDurableDelivery(e); err != nil {
+		return "", fmt.Errorf("invalid endpoint %q: %w", e.Name, err)
+	}
+	if err := validateCircuitBreaker(e.CircuitBreaker); err != nil {
+		return "", fmt.Errorf("invalid endpoint %q: %w", e.Name, err)
+	}
+	if err := validateOutboundRateLimit(e); err != nil {
+		return "", fmt.Errorf("invalid endpoint %q: %w", e.Name, err)
+	}
+	if err := validateConcurrencyLimit(e.ConcurrencyLimit); err != nil {
+		return "", fmt.Errorf("invalid endpoint %q: %w", e.Name, err)
+	}
+	if err := validateSecrets(e); err != nil {
+		return "", fmt.Errorf("invalid endpoint %q: %w", e.Name, err)
+	}
+	return encodeEndpointConfig(e)
+}
+
+// encodeEndpointConfig encodes the endpoint's options to JSON
+// without validating them.
+func encodeEndpointConfig(e *pb.Endpoint) (string, error) {
+	config := proto.Clone(e).(*pb.Endpoint)
+	config.Name = ""
+	config.Primary = false
+	config.Destination = nil
+	return protoMarshaler.MarshalToString(config)
+}
+
+func validateRetryPolicy(p *pb.RetryPolicy) error {
+	if p == nil {
+		return nil
+	}
+	if p.MaxAttempts < 0 {
+		return fmt.Errorf("max attempts can't be negative, found %d", p.MaxAttempts)
+	}
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return fmt.Errorf("jitter should be between 0 and 1, found %v", p.Jitter)
+	}
+	if p.BackoffMultiplier != 0 && p.BackoffMultiplier < 1 {
+		return fmt.Errorf("backoff multiplier can't be less than 1, found %v", p.BackoffMultiplier)
+	}
+	for _, code := range p.RetryableStatusCodes {
+		if code < 100 || code > 599 {
+			return fmt.Errorf("invalid retryable status code %d", code)
+		}
+	}
+	return nil
+}
+
+func validateSampling(e *pb.Endpoint) error {
+	s := e.Sampling
+	if s == nil {
+		return nil
+	}
+	if e.Primary {
+		return errors.New("primary endpoint can't be sampled")
+	}
+	if s.Percentage < 0 || s.Percentage > 100 {
+		return fmt.Errorf("sampling percentage should be between 0 and 100, found %v", s.Percentage)
+	}
+	switch k := s.Key.(type) {
+	case *pb.Sampling_Header:
+		if k.Header == "" {
+			return errors.New("sampling header can't be empty")
+		}
+	case *pb.Sampling_QueryParam:
+		if k.QueryParam == "" {
+			return errors.New("sampling query param can't be empty")
+		}
+	case *pb.Sampling_JsonField:
+		if k.JsonField == "" || strings.HasPrefix(k.JsonField, ".") || strings.HasSuffix(k.JsonField, ".") {
+			return fmt.Errorf("invalid sampling JSON field %q", k.JsonField)
+		}
+	}
+	return nil
+}
+
+var methodRegexp = regexp.MustCompile(`^[A-Z]+$`)
+
+func validateMatchRules(e *pb.Endpoint) error {
+	if len(e.MatchRules) == 0 {
+		return nil
+	}
+	if e.Primary {
+		return errors.New("primary endpoint can't have match rules")
+	}
+	for i, rule := range e.MatchRules {
+		for _, m := range rule.Methods {
+			if !methodRegexp.MatchString(m) {
+				return fmt.Errorf("match rule %d has an invalid method %q", i, m)
+			}
+		}
+		for _, h := range rule.Headers {
+			if h.Name == "" {
+				return fmt.Errorf("match rule %d has a header without a name", i)
+			}
+		}
+		for _, q := range rule.QueryParams {
+			if q.Name == "" {
+				return fmt.Errorf("match rule %d has a query param without a name", i)
+			}
+		}
+		if rule.PathSuffixRegex != "" {
+			if _, err := regexp.Compile(rule.PathSuffixRegex); err != nil {
+				return fmt.Errorf("match rule %d has an invalid path suffix regex: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateTransform(e *pb.Endpoint) error {
+	if e.Transform == nil {
+		return nil
+	}
+	if err := transform.Validate(e.Transform); err != nil {
+		return fmt.Errorf("invalid transform: %w", err)
+	}
+	if len(e.Transform.Body) == 0 {
+		return nil
+	}
+	switch d := e.Destination.(type) {
+	case *pb.Endpoint_GrpcEndpoint:
+		return errors.New("gRPC endpoints can't transform the body")
+	case *pb.Endpoint_TwirpEndpoint:
+		if d.TwirpEndpoint.ContentType != pb.TwirpContentType_TWIRP_CONTENT_TYPE_JSON {
+			return errors.New("protobuf Twirp endpoints can't transform the body")
+		}
+	}
+	return nil
+}
+
+func validateDurableDelivery(e *pb.Endpoint) error {
+	d := e.DurableDelivery
+	if !d.GetEnabled() {
+		return nil
+	}
+	if e.Primary {
+		return errors.New("primary endpoint can't be durable")
+	}
+	switch e.Destination.(type) {
+	case *pb.Endpoint_HttpEndpoint, *pb.Endpoint_TwirpEndpoint:
+	default:
+		return errors.New("only HTTP and Twirp endpoints can be durable")
+	}
+	if d.MaxAttempts < 0 {
+		return fmt.Errorf("max delivery attempts can't be negative, found %d", d.MaxAttempts)
+	}
+	if d.InitialBackoffMs < 0 || d.MaxBackoffMs < 0 {
+		return errors.New("delivery backoff can't be negative")
+	}
+	return nil
+}
+
+func validateCircuitBreaker(c *pb.CircuitBreaker) error {
+	if c == nil {
+		return nil
+	}
+	if c.ErrorRate < 0 || c.ErrorRate > 1 {
+		return fmt.Errorf("circuit breaker error rate should be between 0 and 1, found %v", c.ErrorRate)
+	}
+	if c.ConsecutiveFailures < 0 || c.MinRequests < 0 || c.HalfOpenRequests < 0 {
+		return errors.New("circuit breaker thresholds can't be negative")
+	}
+	if c.WindowMs < 0 || c.OpenMs < 0 {
+		return errors.New("circuit breaker durations can't be negative")
+	}
+	return nil
+}
+
+func validateOutboundRateLimit(e *pb.Endpoint) error {
+	c := e.OutboundRateLimit
+	if c == nil {
+		return nil
+	}
+	if c.RequestsPerSecond < 0 {
+		return fmt.Errorf("outbound requests per second can't be negative, found %v", c.RequestsPerSecond)
+	}
+	if c.Burst < 0 {
+		return fmt.Errorf("outbound burst can't be negative, found %d", c.Burst)
+	}
+	if c.MaxInFlight < 0 {
+		return fmt.Errorf("max in-flight requests can't be negative, found %d", c.MaxInFlight)
+	}
+	switch c.Action {
+	case pb.OverLimitAction_OVER_LIMIT_ACTION_DEFAULT, pb.OverLimitAction_OVER_LIMIT_ACTION_DROP:
+	case pb.OverLimitAction_OVER_LIMIT_ACTION_WAIT, pb.OverLimitAction_OVER_LIMIT_ACTION_FAIL:
+		if !e.Primary {
+			return fmt.Errorf("over limit action %v is only allowed for the primary endpoint", c.Action)
+		}
+	case pb.OverLimitAction_OVER_LIMIT_ACTION_DEFER:
+		if e.Primary {
+			return fmt.Errorf("over limit action %v isn't allowed for the primary endpoint", c.Action)
+		}
+	default:
+		return fmt.Errorf("unknown over limit action %v", c.Action)
+	}
+	if c.Action == pb.OverLimitAction_OVER_LIMIT_ACTION_DEFER {
+		switch e.Destination.(type) {
+		case *pb.Endpoint_HttpEndpoint, *pb.Endpoint_TwirpEndpoint:
+		default:
+			return errors.New("only HTTP and Twirp endpoints can defer over limit requests")
+		}
+	}
+	return nil
+}
+
+func validateConcurrencyLimit(c *pb.ConcurrencyLimit) error {
+	if c == nil {
+		return nil
+	}
+	if c.MaxRequests < 0 {
+		return fmt.Errorf("max concurrent requests can't be negative, found %d", c.MaxRequests)
+	}
+	if c.MaxQueueMs < 0 {
+		return fmt.Errorf("max queue time can't be negative, found %d", c.MaxQueueMs)
+	}
+	return nil
+}
+
+// destinationColumns are the JSON encoded destination columns
+// of an endpoint. Only the column of the endpoint's destination is set.
+type destinationColumns struct {
+	httpEndpoint   *string
+	grpcEndpoint   *string
+	twirpEndpoint  *string
+	fanoutEndpoint *string
+}
+
+func marshalDestination(e *pb.Endpoint) (*destinationColumns, error) {
+	var d destinationColumns
 	switch endpoint := e.Destination.(type) {
 	case *pb.Endpoint_HttpEndpoint:
-		// TODO: Validate the endpoint.
-		httpEndpoint, err := protoMarshaler.MarshalToString(endpoint.HttpEndpoint)
+		if err := validateHTTPEndpoint(endpoint.HttpEndpoint); err != nil {
+			return nil, fmt.Errorf("invalid endpoint %q: %w", e.Name, err)
+		}
+		v, err := protoMarshaler.MarshalToString(endpoint.HttpEndpoint)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		_, err = tx.Exec(ctx,
-			`UPDATE endpoints
-			 SET is_primary = $1, http_endpoint = $2, updated_at = NOW()
-			 WHERE fanout_name = $3 AND endpoint_name = $4`, e.Primary, httpEndpoint, fanout, e.Name)
-		return err
+		d.httpEndpoint = &v
+	case *pb.Endpoint_GrpcEndpoint:
+		if err := validateGRPCEndpoint(endpoint.GrpcEndpoint); err != nil {
+			return nil, fmt.Errorf("invalid endpoint %q: %w", e.Name, err)
+		}
+		v, err := protoMarshaler.MarshalToString(endpoint.GrpcEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		d.grpcEndpoint = &v
+	case *pb.Endpoint_TwirpEndpoint:
+		if err := validateTwirpEndpoint(endpoint.TwirpEndpoint); err != nil {
+			return nil, fmt.Errorf("invalid endpoint %q: %w", e.Name, err)
+		}
+		v, err := protoMarshaler.MarshalToString(endpoint.TwirpEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		d.twirpEndpoint = &v
+	case *pb.Endpoint_FanoutEndpoint:
+		if endpoint.FanoutEndpoint.Fanout == "" {
+			return nil, fmt.Errorf("invalid endpoint %q: missing fanout", e.Name)
+		}
+		v, err := protoMarshaler.MarshalToString(endpoint.FanoutEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		d.fanoutEndpoint = &v
 	default:
-		panic("not supported endpoint")
+		return nil, fmt.Errorf("endpoint %q has an unsupported destination", e.Name)
+	}
+	return &d, nil
+}
+
+func validateHTTPEndpoint(e *pb.HTTPEndpoint) error {
+	if e.Url == "" {
+		return errors.New("missing URL")
+	}
+	if urltemplate.IsTemplate(e.Url) {
+		if _, err := urltemplate.Parse(e.Url); err != nil {
+			return fmt.Errorf("invalid URL template: %w", err)
+		}
+		return nil
+	}
+	u, err := url.Parse(e.Url)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("URL should be an http or https URL, found %q", e.Url)
 	}
+	return nil
+}
+
+func validateGRPCEndpoint(e *pb.GRPCEndpoint) error {
+	if e.Target == "" {
+		return errors.New("missing target")
+	}
+	// Full method names are in the form of "/package.Service/Method".
+	if !strings.HasPrefix(e.Method, "/") || strings.Count(e.Method, "/") != 2 {
+		return fmt.Errorf("method should be in the form of /package.Service/Method, found %q", e.Method)
+	}
+	return nil
+}
+
+func validateTwirpEndpoint(e *pb.TwirpEndpoint) error {
+	u, err := url.Parse(e.BaseUrl)
+	if err != nil {
+		return fmt.Errorf("invalid base URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("base URL should be an HTTP or HTTPS URL, found %q", e.BaseUrl)
+	}
+	if e.Service == "" || e.Method == "" {
+		return errors.New("missing service or method")
+	}
+	return nil
 }
 
 func (s *adminService) validatePrimaryCount(ctx context.Context, tx pgx.Tx, fanout string) error {
@@ -182,3 +914,275 @@ func (s *adminService) validatePrimaryCount(ctx context.Context, tx pgx.Tx, fano
 	}
 	return nil
 }
+
+// validateFanoutConfig validates the fanout's config against its
+// endpoints, e.g. the fanout's quorum can be reached by its endpoints.
+func (s *adminService) validateFanoutConfig(ctx context.Context, tx pgx.Tx, fanout string) error {
+	var fanoutConfig pb.FanoutConfig
+	var data *string
+	err := tx.QueryRow(ctx,
+		`SELECT config FROM fanouts WHERE fanout_name = $1`, fanout).Scan(&data)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+	if data != nil {
+		if err := jsonpb.UnmarshalString(*data, &fanoutConfig); err != nil {
+			return err
+		}
+	}
+	if fanoutConfig.Quorum < 0 {
+		return fmt.Errorf("quorum can't be negative, found %d", fanoutConfig.Quorum)
+	}
+
+	rows, err := tx.Query(ctx,
+		`SELECT endpoint_name, config FROM endpoints WHERE fanout_name = $1`, fanout)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var quorumEndpoints int
+	names := make(map[string]bool)
+	for rows.Next() {
+		var (
+			name   string
+			config *string
+		)
+		if err := rows.Scan(&name, &config); err != nil {
+			return err
+		}
+		names[name] = true
+		if config == nil {
+			continue
+		}
+		var e pb.Endpoint
+		if err := jsonpb.UnmarshalString(*config, &e); err != nil {
+			return err
+		}
+		if e.FailureMode == pb.FailureMode_FAILURE_MODE_QUORUM {
+			quorumEndpoints++
+		}
+		// Cancelled hedges must not be delivered later.
+		if fanoutConfig.Mode == pb.FanoutMode_FANOUT_MODE_HEDGE &&
+			(e.DurableDelivery.GetEnabled() || e.OutboundRateLimit.GetAction() == pb.OverLimitAction_OVER_LIMIT_ACTION_DEFER) {
+			return fmt.Errorf("endpoint %q of a hedged fanout can't be durable or defer requests", name)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if int(fanoutConfig.Quorum) > quorumEndpoints {
+		return fmt.Errorf("quorum of %d can't be reached by %d endpoint(s) in quorum mode", fanoutConfig.Quorum, quorumEndpoints)
+	}
+	if err := validateAggregate(fanoutConfig.Aggregate, names); err != nil {
+		return err
+	}
+	if err := validateConcurrencyLimit(fanoutConfig.ConcurrencyLimit); err != nil {
+		return err
+	}
+	if err := validateRateLimit(fanoutConfig.RateLimit); err != nil {
+		return err
+	}
+	if err := validateInboundAuth(fanoutConfig.InboundAuth); err != nil {
+		return err
+	}
+	return validateHedge(fanoutConfig.Hedge)
+}
+
+func validateInboundAuth(c *pb.InboundAuth) error {
+	if c == nil {
+		return nil
+	}
+	tokens := make([]auth.Token, 0, len(c.ApiKeys))
+	for _, k := range c.ApiKeys {
+		tokens = append(tokens, auth.Token{Subject: k.Name, SHA256: k.Sha256})
+	}
+	if _, err := auth.NewTokens(tokens); err != nil {
+		return fmt.Errorf("invalid API key: %w", err)
+	}
+	ids := make(map[string]bool, len(c.HmacKeys))
+	for _, k := range c.HmacKeys {
+		if k.Id == "" {
+			return errors.New("HMAC key ID can't be empty")
+		}
+		if ids[k.Id] {
+			return fmt.Errorf("duplicate HMAC key %q", k.Id)
+		}
+		ids[k.Id] = true
+		switch {
+		case k.Secret == "" && k.SecretRef == "":
+			return fmt.Errorf("HMAC key %q has no secret", k.Id)
+		case k.Secret != "" && k.SecretRef != "":
+			return fmt.Errorf("HMAC key %q has both a secret and a secret reference", k.Id)
+		case k.Secret == secrets.Redacted:
+			return fmt.Errorf("HMAC key %q has a redacted secret, set the secret or reference it", k.Id)
+		case k.SecretRef != "":
+			if err := secrets.Validate(k.SecretRef); err != nil {
+				return fmt.Errorf("HMAC key %q: %w", k.Id, err)
+			}
+		}
+	}
+	if c.HmacMaxSkewMs < 0 {
+		return fmt.Errorf("HMAC max skew can't be negative, found %d", c.HmacMaxSkewMs)
+	}
+	if j := c.Jwt; j != nil {
+		if _, err := auth.NewJWTWithKeys([]byte(j.Jwks), j.Issuer, j.Audience); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateRateLimit(c *pb.RateLimit) error {
+	if c == nil {
+		return nil
+	}
+	if c.RequestsPerSecond < 0 {
+		return fmt.Errorf("requests per second can't be negative, found %v", c.RequestsPerSecond)
+	}
+	if c.Burst < 0 {
+		return fmt.Errorf("burst can't be negative, found %d", c.Burst)
+	}
+	if k, ok := c.Key.(*pb.RateLimit_Header); ok && k.Header == "" {
+		return errors.New("rate limit header can't be empty")
+	}
+	return nil
+}
+
+func validateHedge(c *pb.HedgeConfig) error {
+	if c == nil {
+		return nil
+	}
+	if c.DelayMs < 0 {
+		return fmt.Errorf("hedge delay can't be negative, found %d", c.DelayMs)
+	}
+	if c.LatencyPercentile < 0 || c.LatencyPercentile >= 100 {
+		return fmt.Errorf("hedge latency percentile should be between 0 and 100, found %v", c.LatencyPercentile)
+	}
+	if c.MaxHedges < 0 {
+		return fmt.Errorf("max hedges can't be negative, found %d", c.MaxHedges)
+	}
+	return nil
+}
+
+func validateAggregate(c *pb.AggregateConfig, endpoints map[string]bool) error {
+	if c == nil {
+		return nil
+	}
+	if c.MaxResponseBytes < 0 {
+		return fmt.Errorf("max response bytes can't be negative, found %d", c.MaxResponseBytes)
+	}
+	seen := make(map[string]bool)
+	for _, name := range c.Endpoints {
+		if !endpoints[name] {
+			return fmt.Errorf("aggregated endpoint %q doesn't exist", name)
+		}
+		if seen[name] {
+			return fmt.Errorf("endpoint %q is aggregated more than once", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// validateFanoutGraph validates the fanouts called by fanout endpoints
+// exist, and fanouts don't call each other in cycles.
+func (s *adminService) validateFanoutGraph(ctx context.Context, tx pgx.Tx) error {
+	rows, err := tx.Query(ctx,
+		`SELECT DISTINCT fanout_name FROM endpoints`)
+	if err != nil {
+		return err
+	}
+	fanouts, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return err
+	}
+
+	rows, err = tx.Query(ctx,
+		`SELECT fanout_name, fanout_endpoint
+		 FROM endpoints
+		 WHERE fanout_endpoint IS NOT NULL`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	graph := make(map[string][]string, len(fanouts))
+	for _, f := range fanouts {
+		graph[f] = nil
+	}
+	var (
+		fanoutName     string
+		fanoutEndpoint string
+	)
+	for rows.Next() {
+		if err := rows.Scan(&fanoutName, &fanoutEndpoint); err != nil {
+			return err
+		}
+		var d pb.FanoutEndpoint
+		if err := jsonpb.UnmarshalString(fanoutEndpoint, &d); err != nil {
+			return err
+		}
+		if _, ok := graph[d.Fanout]; !ok {
+			return fmt.Errorf("fanout %q calls %q that doesn't exist", fanoutName, d.Fanout)
+		}
+		graph[fanoutName] = append(graph[fanoutName], d.Fanout)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if cycle := findCycle(graph); cycle != nil {
+		return fmt.Errorf("fanouts can't call each other in cycles: %s", strings.Join(cycle, " -> "))
+	}
+	return nil
+}
+
+// findCycle returns a cycle in the graph, or nil if the graph is acyclic.
+func findCycle(graph map[string][]string) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(graph))
+	var path []string
+
+	var visit func(n string) []string
+	visit = func(n string) []string {
+		state[n] = visiting
+		path = append(path, n)
+		for _, next := range graph[n] {
+			switch state[next] {
+			case visiting:
+				for i, p := range path {
+					if p == next {
+						return append(append([]string{}, path[i:]...), next)
+					}
+				}
+			case unvisited:
+				if cycle := visit(next); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[n] = visited
+		return nil
+	}
+
+	// Visit in a stable order to report the same cycle every time.
+	nodes := make([]string, 0, len(graph))
+	for n := range graph {
+		nodes = append(nodes, n)
+	}
+	sort.Strings(nodes)
+	for _, n := range nodes {
+		if state[n] == unvisited {
+			if cycle := visit(n); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFindCycle(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph map[string][]string
+		want  []string
+	}{
+		{
+			name:  "empty",
+			graph: map[string][]string{},
+		},
+		{
+			name:  "no calls",
+			graph: map[string][]string{"a": nil, "b": nil},
+		},
+		{
+			name:  "chain",
+			graph: map[string][]string{"a": {"b"}, "b": {"c"}, "c": nil},
+		},
+		{
+			name:  "diamond",
+			graph: map[string][]string{"a": {"b", "c"}, "b": {"d"}, "c": {"d"}, "d": nil},
+		},
+		{
+			name:  "self call",
+			graph: map[string][]string{"a": {"a"}},
+			want:  []string{"a", "a"},
+		},
+		{
+			name:  "two fanouts",
+			graph: map[string][]string{"a": {"b"}, "b": {"a"}},
+			want:  []string{"a", "b", "a"},
+		},
+		{
+			name:  "cycle after a chain",
+			graph: map[string][]string{"a": {"b"}, "b": {"c"}, "c": {"d"}, "d": {"b"}},
+			want:  []string{"b", "c", "d", "b"},
+		},
+		{
+			name:  "cycle not reachable from the first fanout",
+			graph: map[string][]string{"a": nil, "x": {"y"}, "y": {"z"}, "z": {"x"}},
+			want:  []string{"x", "y", "z", "x"},
+		},
+	}
+	for _, tt := range tests {
+		if got := findCycle(tt.graph); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: findCycle() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dfanout/dfanout/auth"
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/dfanout/dfanout/secrets"
+	"github.com/twitchtv/twirp"
+)
+
+// adminRoles is the role required by each admin RPC on the
+// fanout of the request. RPCs that aren't listed require admin.
+var adminRoles = map[string]auth.Role{
+	"GetFanout":           auth.Viewer,
+	"GetMismatches":       auth.Viewer,
+	"ListDeadLetters":     auth.Viewer,
+	"GetDeadLetter":       auth.Viewer,
+	"CreateFanout":        auth.Editor,
+	"UpdateFanout":        auth.Editor,
+	"SetEndpointSampling": auth.Editor,
+	"SetRateLimit":        auth.Editor,
+	"ReplayDeadLetters":   auth.Editor,
+	"DeleteFanout":        auth.Admin,
+	"PurgeDeadLetters":    auth.Admin,
+	"SetSecret":           auth.Admin,
+	"DeleteSecret":        auth.Admin,
+	"ListSecrets":         auth.Admin,
+}
+
+// authorizeAdminRequests rejects the admin RPCs of callers that aren't
+// authenticated by auth.Middleware, or that don't have the RPC's role
+// on the fanout of the request, on the fanouts its endpoints call and
+// on the names of the secrets of the table it references.
+func authorizeAdminRequests(policy *auth.Policy) twirp.Interceptor {
+	return func(next twirp.Method) twirp.Method {
+		return func(ctx context.Context, req any) (any, error) {
+			id, err := auth.FromContext(ctx)
+			if errors.Is(err, auth.ErrNoCredentials) {
+				return nil, twirp.NewError(twirp.Unauthenticated, "missing or unknown credentials")
+			}
+			if err != nil {
+				return nil, twirp.NewError(twirp.Unauthenticated, err.Error())
+			}
+
+			method, _ := twirp.MethodName(ctx)
+			role, ok := adminRoles[method]
+			if !ok {
+				role = auth.Admin
+			}
+			if _, ok := req.(*pb.GetDeadLetterRequest); ok {
+				// The fanout is known once the dead letter is read.
+				resp, err := next(ctx, req)
+				if err != nil {
+					return nil, err
+				}
+				fanout := resp.(*pb.GetDeadLetterResponse).GetDeadLetter().GetFanoutName()
+				if !policy.Allowed(id.Subject, role, fanout) {
+					return nil, permissionDenied(id, role, fanout)
+				}
+				return resp, nil
+			}
+			// Fanout endpoints expose the fanouts they call.
+			for _, fanout := range append([]string{fanoutName(req)}, calledFanouts(req)...) {
+				if !policy.Allowed(id.Subject, role, fanout) {
+					return nil, permissionDenied(id, role, fanout)
+				}
+			}
+			// Endpoints can send the secrets they reference anywhere, so
+			// the secrets of the table are scoped like the fanouts.
+			for _, ref := range secretRefs(req) {
+				if name, ok := secrets.DBName(ref); ok && !policy.Allowed(id.Subject, role, name) {
+					return nil, twirp.NewErrorf(twirp.PermissionDenied, "%s doesn't have the %s role on the secret %q", id.Subject, role, name)
+				}
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// calledFanouts returns the fanouts called by the
+// fanout endpoints written by the request.
+func calledFanouts(req any) []string {
+	var endpoints []*pb.Endpoint
+	switch r := req.(type) {
+	case *pb.CreateFanoutRequest:
+		endpoints = r.Endpoints
+	case *pb.UpdateFanoutRequest:
+		endpoints = append(append(endpoints, r.EndpointsToInsert...), r.EndpointsToUpdate...)
+	}
+	var fanouts []string
+	for _, e := range endpoints {
+		if d, ok := e.Destination.(*pb.Endpoint_FanoutEndpoint); ok {
+			fanouts = append(fanouts, d.FanoutEndpoint.Fanout)
+		}
+	}
+	return fanouts
+}
+
+func permissionDenied(id *auth.Identity, role auth.Role, fanout string) error {
+	if fanout == "" {
+		return twirp.NewErrorf(twirp.PermissionDenied, "%s doesn't have the %s role on all fanouts", id.Subject, role)
+	}
+	return twirp.NewErrorf(twirp.PermissionDenied, "%s doesn't have the %s role on %q", id.Subject, role, fanout)
+}
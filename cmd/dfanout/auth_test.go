@@ -0,0 +1,296 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/dfanout/dfanout/auth"
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/twitchtv/twirp"
+	"github.com/twitchtv/twirp/ctxsetters"
+)
+
+func TestAdminRoles(t *testing.T) {
+	service := reflect.TypeOf((*pb.AdminService)(nil)).Elem()
+	rpcs := make(map[string]bool, service.NumMethod())
+	for i := 0; i < service.NumMethod(); i++ {
+		rpcs[service.Method(i).Name] = true
+	}
+	want := map[string]auth.Role{
+		"GetFanout":           auth.Viewer,
+		"GetMismatches":       auth.Viewer,
+		"ListDeadLetters":     auth.Viewer,
+		"GetDeadLetter":       auth.Viewer,
+		"CreateFanout":        auth.Editor,
+		"UpdateFanout":        auth.Editor,
+		"SetEndpointSampling": auth.Editor,
+		"SetRateLimit":        auth.Editor,
+		"ReplayDeadLetters":   auth.Editor,
+		"DeleteFanout":        auth.Admin,
+		"PurgeDeadLetters":    auth.Admin,
+		"SetSecret":           auth.Admin,
+		"DeleteSecret":        auth.Admin,
+		"ListSecrets":         auth.Admin,
+	}
+	// New RPCs should be given a role on purpose.
+	for rpc := range rpcs {
+		if _, ok := want[rpc]; !ok {
+			t.Errorf("RPC %s has no expected role", rpc)
+		}
+	}
+	for rpc, role := range want {
+		if !rpcs[rpc] {
+			t.Errorf("%s isn't an RPC", rpc)
+		}
+		if got, ok := adminRoles[rpc]; !ok || got != role {
+			t.Errorf("adminRoles[%s] = %v, want %v", rpc, got, role)
+		}
+	}
+	for rpc := range adminRoles {
+		if !rpcs[rpc] {
+			t.Errorf("adminRoles has %s, which isn't an RPC", rpc)
+		}
+	}
+}
+
+func TestAuthorizeAdminRequests(t *testing.T) {
+	policy, err := auth.NewPolicy([]auth.Binding{
+		{Subject: "root", Role: auth.Admin},
+		{Subject: "reader", Role: auth.Viewer},
+		{Subject: "team", Role: auth.Admin, FanoutPrefix: "team-"},
+		{Subject: "dev", Role: auth.Editor, FanoutPrefix: "team-"},
+		{Subject: "dev", Role: auth.Viewer, FanoutPrefix: "shared-"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fanoutEndpoint := func(fanout string) *pb.Endpoint {
+		return &pb.Endpoint{Name: "e", Destination: &pb.Endpoint_FanoutEndpoint{
+			FanoutEndpoint: &pb.FanoutEndpoint{Fanout: fanout},
+		}}
+	}
+	secretEndpoint := func(ref string) *pb.Endpoint {
+		return &pb.Endpoint{Name: "e", Destination: &pb.Endpoint_HttpEndpoint{
+			HttpEndpoint: &pb.HTTPEndpoint{Url: "https://example.com", Header: []*pb.Header{{Key: "Authorization", ValueRef: ref}}},
+		}}
+	}
+	allRPCs := []struct {
+		method string
+		req    any
+	}{
+		{"GetFanout", &pb.GetFanoutRequest{FanName: "team-a"}},
+		{"GetMismatches", &pb.GetMismatchesRequest{FanoutName: "team-a"}},
+		{"ListDeadLetters", &pb.ListDeadLettersRequest{FanoutName: "team-a"}},
+		{"GetDeadLetter", &pb.GetDeadLetterRequest{Id: 1}},
+		{"CreateFanout", &pb.CreateFanoutRequest{FanoutName: "team-a"}},
+		{"UpdateFanout", &pb.UpdateFanoutRequest{FanoutName: "team-a"}},
+		{"SetEndpointSampling", &pb.SetEndpointSamplingRequest{FanoutName: "team-a"}},
+		{"SetRateLimit", &pb.SetRateLimitRequest{FanoutName: "team-a"}},
+		{"ReplayDeadLetters", &pb.ReplayDeadLettersRequest{FanoutName: "team-a"}},
+		{"DeleteFanout", &pb.DeleteFanoutRequest{FanoutName: "team-a"}},
+		{"PurgeDeadLetters", &pb.PurgeDeadLettersRequest{FanoutName: "team-a"}},
+		{"SetSecret", &pb.SetSecretRequest{Name: "team-a"}},
+		{"DeleteSecret", &pb.DeleteSecretRequest{Name: "team-a"}},
+		{"ListSecrets", &pb.ListSecretsRequest{}},
+	}
+
+	type test struct {
+		name    string
+		subject string // empty if unauthenticated
+		method  string
+		req     any
+		want    twirp.ErrorCode // empty if allowed
+	}
+	var tests []test
+	// Each RPC requires its role on the fanout.
+	for _, rpc := range allRPCs {
+		role := adminRoles[rpc.method]
+		for _, s := range []struct {
+			subject string
+			role    auth.Role
+		}{
+			{"reader", auth.Viewer},
+			{"dev", auth.Editor},
+			{"root", auth.Admin},
+		} {
+			tt := test{name: rpc.method + " by " + s.subject, subject: s.subject, method: rpc.method, req: rpc.req}
+			if s.role < role {
+				tt.want = twirp.PermissionDenied
+			}
+			tests = append(tests, tt)
+		}
+		tests = append(tests, test{name: rpc.method + " unauthenticated", method: rpc.method, req: rpc.req, want: twirp.Unauthenticated})
+	}
+	tests = append(tests, []test{
+		{
+			name:    "unknown RPCs require admin",
+			subject: "dev",
+			method:  "Unknown",
+			req:     &pb.GetFanoutRequest{FanName: "team-a"},
+			want:    twirp.PermissionDenied,
+		},
+		{
+			name:    "prefixed admin",
+			subject: "team",
+			method:  "DeleteFanout",
+			req:     &pb.DeleteFanoutRequest{FanoutName: "team-a"},
+		},
+		{
+			name:    "another prefix",
+			subject: "team",
+			method:  "GetFanout",
+			req:     &pb.GetFanoutRequest{FanName: "other"},
+			want:    twirp.PermissionDenied,
+		},
+		{
+			name:    "role of another prefix",
+			subject: "dev",
+			method:  "UpdateFanout",
+			req:     &pb.UpdateFanoutRequest{FanoutName: "shared-a"},
+			want:    twirp.PermissionDenied,
+		},
+		{
+			name:    "lower role of another prefix",
+			subject: "dev",
+			method:  "GetFanout",
+			req:     &pb.GetFanoutRequest{FanName: "shared-a"},
+		},
+		{
+			name:    "mismatches of another prefix",
+			subject: "team",
+			method:  "GetMismatches",
+			req:     &pb.GetMismatchesRequest{FanoutName: "other"},
+			want:    twirp.PermissionDenied,
+		},
+		{
+			name:    "dead letters of another prefix",
+			subject: "team",
+			method:  "ListDeadLetters",
+			req:     &pb.ListDeadLettersRequest{FanoutName: "other"},
+			want:    twirp.PermissionDenied,
+		},
+		{
+			name:    "dead letters of all fanouts",
+			subject: "team",
+			method:  "ListDeadLetters",
+			req:     &pb.ListDeadLettersRequest{},
+			want:    twirp.PermissionDenied,
+		},
+		{
+			name:    "dead letter of another prefix",
+			subject: "team",
+			method:  "GetDeadLetter",
+			req:     &pb.GetDeadLetterRequest{Id: 2},
+			want:    twirp.PermissionDenied,
+		},
+		{
+			name:    "replaying dead letters of another prefix",
+			subject: "team",
+			method:  "ReplayDeadLetters",
+			req:     &pb.ReplayDeadLettersRequest{FanoutName: "other", Ids: []int64{2}},
+			want:    twirp.PermissionDenied,
+		},
+		{
+			name:    "purging dead letters of another prefix",
+			subject: "team",
+			method:  "PurgeDeadLetters",
+			req:     &pb.PurgeDeadLettersRequest{FanoutName: "other"},
+			want:    twirp.PermissionDenied,
+		},
+		{
+			name:    "calling a fanout of the prefix",
+			subject: "dev",
+			method:  "CreateFanout",
+			req:     &pb.CreateFanoutRequest{FanoutName: "team-a", Endpoints: []*pb.Endpoint{fanoutEndpoint("team-b")}},
+		},
+		{
+			name:    "calling a fanout of another prefix",
+			subject: "dev",
+			method:  "CreateFanout",
+			req:     &pb.CreateFanoutRequest{FanoutName: "team-a", Endpoints: []*pb.Endpoint{fanoutEndpoint("shared-a")}},
+			want:    twirp.PermissionDenied,
+		},
+		{
+			name:    "inserting an endpoint calling a fanout of another prefix",
+			subject: "dev",
+			method:  "UpdateFanout",
+			req:     &pb.UpdateFanoutRequest{FanoutName: "team-a", EndpointsToInsert: []*pb.Endpoint{fanoutEndpoint("other")}},
+			want:    twirp.PermissionDenied,
+		},
+		{
+			name:    "updating an endpoint to call a fanout of another prefix",
+			subject: "dev",
+			method:  "UpdateFanout",
+			req:     &pb.UpdateFanoutRequest{FanoutName: "team-a", EndpointsToUpdate: []*pb.Endpoint{fanoutEndpoint("other")}},
+			want:    twirp.PermissionDenied,
+		},
+		{
+			name:    "secret of the prefix",
+			subject: "dev",
+			method:  "CreateFanout",
+			req:     &pb.CreateFanoutRequest{FanoutName: "team-a", Endpoints: []*pb.Endpoint{secretEndpoint("db:team-token")}},
+		},
+		{
+			name:    "secret of another prefix",
+			subject: "dev",
+			method:  "CreateFanout",
+			req:     &pb.CreateFanoutRequest{FanoutName: "team-a", Endpoints: []*pb.Endpoint{secretEndpoint("db:root-token")}},
+			want:    twirp.PermissionDenied,
+		},
+		{
+			name:    "HMAC secret of another prefix",
+			subject: "dev",
+			method:  "UpdateFanout",
+			req: &pb.UpdateFanoutRequest{FanoutName: "team-a", Config: &pb.FanoutConfig{InboundAuth: &pb.InboundAuth{
+				HmacKeys: []*pb.HMACKey{{Id: "a", SecretRef: "db:root-hmac"}},
+			}}},
+			want: twirp.PermissionDenied,
+		},
+		{
+			name:    "secrets of any name",
+			subject: "root",
+			method:  "CreateFanout",
+			req:     &pb.CreateFanoutRequest{FanoutName: "team-a", Endpoints: []*pb.Endpoint{secretEndpoint("db:root-token")}},
+		},
+		{
+			// Environment variables and files are limited by the secrets policy.
+			name:    "env secret",
+			subject: "dev",
+			method:  "CreateFanout",
+			req:     &pb.CreateFanoutRequest{FanoutName: "team-a", Endpoints: []*pb.Endpoint{secretEndpoint("env:DFANOUT_SECRET_TOKEN")}},
+		},
+	}...)
+
+	// Dead letter 1 is of team-a, the others of other.
+	next := func(ctx context.Context, req any) (any, error) {
+		if r, ok := req.(*pb.GetDeadLetterRequest); ok {
+			fanout := "other"
+			if r.Id == 1 {
+				fanout = "team-a"
+			}
+			return &pb.GetDeadLetterResponse{DeadLetter: &pb.DeadLetter{Id: r.Id, FanoutName: fanout}}, nil
+		}
+		return "ok", nil
+	}
+	method := authorizeAdminRequests(policy)(next)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := ctxsetters.WithMethodName(context.Background(), tt.method)
+			if tt.subject != "" {
+				ctx = auth.NewContext(ctx, &auth.Identity{Subject: tt.subject, Method: "token"})
+			}
+			_, err := method(ctx, tt.req)
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("error = %v, want allowed", err)
+				}
+				return
+			}
+			twerr, ok := err.(twirp.Error)
+			if !ok || twerr.Code() != tt.want {
+				t.Errorf("error = %v, want %s", err, tt.want)
+			}
+		})
+	}
+}
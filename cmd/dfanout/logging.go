@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/dfanout/dfanout/auth"
+	"github.com/dfanout/dfanout/logging"
+	"github.com/twitchtv/twirp"
+)
+
+// logAdminRequests logs the admin service's RPCs
+// with the same fields as the fanout requests.
+func logAdminRequests(next twirp.Method) twirp.Method {
+	return func(ctx context.Context, req any) (any, error) {
+		start := time.Now()
+		resp, err := next(ctx, req)
+
+		method, _ := twirp.MethodName(ctx)
+		attrs := []any{
+			logging.Method, method,
+			logging.Fanout, fanoutName(req),
+			logging.Took(time.Since(start)),
+		}
+		if id, err := auth.FromContext(ctx); err == nil {
+			attrs = append(attrs, logging.Subject, id.Subject)
+		}
+		level := slog.LevelInfo
+		if err != nil {
+			attrs = append(attrs, logging.Error, err)
+			level = slog.LevelWarn
+		}
+		logging.FromContext(ctx).Log(ctx, level, "Served admin request", attrs...)
+		return resp, err
+	}
+}
+
+func fanoutName(req any) string {
+	switch r := req.(type) {
+	case interface{ GetFanoutName() string }:
+		return r.GetFanoutName()
+	case interface{ GetFanName() string }:
+		return r.GetFanName()
+	}
+	return ""
+}
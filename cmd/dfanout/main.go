@@ -2,61 +2,285 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"flag"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/dfanout/dfanout/auth"
 	"github.com/dfanout/dfanout/fanout"
 	"github.com/dfanout/dfanout/fanout/clientcache"
+	"github.com/dfanout/dfanout/fanout/compare"
+	"github.com/dfanout/dfanout/fanout/outbox"
+	"github.com/dfanout/dfanout/fanout/tee"
+	"github.com/dfanout/dfanout/logging"
+	"github.com/dfanout/dfanout/metrics"
 	pb "github.com/dfanout/dfanout/proto"
+	"github.com/dfanout/dfanout/ratelimit"
+	"github.com/dfanout/dfanout/secrets"
+	"github.com/dfanout/dfanout/tracing"
 	"github.com/gorilla/mux"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/twitchtv/twirp"
 )
 
 var (
 	listen       string
+	self         string
 	peers        string
 	postgresConn string
+
+	bodyMemoryLimit int64
+	bodyMaxSize     int64
+	bodySpillDir    string
+
+	traceExporter     string
+	traceOTLPEndpoint string
+	traceOTLPInsecure bool
+	traceFile         string
+	traceSampleRatio  float64
+
+	logLevel      string
+	logSampleRate float64
+
+	backgroundWorkers int
+	backgroundQueue   int
+	backgroundTimeout time.Duration
+
+	maxOutboundRequests int
+
+	outboxPollInterval time.Duration
+	outboxWorkers      int
+
+	authConfig  string
+	tlsCert     string
+	tlsKey      string
+	tlsClientCA string
+
+	secretsKeyFile      string
+	secretsPollInterval time.Duration
+	secretsDir          string
+	secretsEnvPrefix    string
+
+	peerTokenFile string
+
+	mismatchesFlushInterval time.Duration
 )
 
 func main() {
 	ctx := context.Background()
 
 	flag.StringVar(&listen, "listen", ":8080", "")
-	flag.StringVar(&peers, "peers", "", "")
+	flag.StringVar(&self, "self", "", "base URL the peers reach this node at, as listed in -peers; required with -peers")
+	flag.StringVar(&peers, "peers", "", "comma separated base URLs of the peers, e.g. http://10.0.0.1:8080")
+	flag.StringVar(&peerTokenFile, "peer-token-file", "", "file with the token the peers authenticate each other with; required with -peers")
 	flag.StringVar(&postgresConn, "postgres-connection", "postgres://postgres:@localhost:5432/dfanout", "")
+	flag.Int64Var(&bodyMemoryLimit, "body-memory-limit", tee.DefaultMemoryLimit, "max bytes of a request body to buffer in memory")
+	flag.Int64Var(&bodyMaxSize, "body-max-size", tee.DefaultMaxSize, "max bytes of a request body to mirror to endpoints")
+	flag.StringVar(&bodySpillDir, "body-spill-dir", "", "directory to spill large request bodies to")
+	flag.StringVar(&traceExporter, "trace-exporter", tracing.ExporterNone, "trace exporter; none, otlp or stdout")
+	flag.StringVar(&traceOTLPEndpoint, "trace-otlp-endpoint", "", "host:port of the OTLP HTTP trace receiver")
+	flag.BoolVar(&traceOTLPInsecure, "trace-otlp-insecure", false, "disable TLS for the OTLP trace exporter")
+	flag.StringVar(&traceFile, "trace-file", "", "file to write spans to with the stdout exporter")
+	flag.Float64Var(&traceSampleRatio, "trace-sample-ratio", 1, "fraction of new traces to sample")
+	flag.StringVar(&logLevel, "log-level", "info", "minimum log level; debug, info, warn or error")
+	flag.Float64Var(&logSampleRate, "log-sample-rate", 1, "fraction of requests to log below the warn level")
+	flag.IntVar(&backgroundWorkers, "background-workers", fanout.DefaultBackgroundWorkers, "number of workers sending requests to endpoints fans don't wait for")
+	flag.IntVar(&backgroundQueue, "background-queue", fanout.DefaultBackgroundQueue, "max background requests waiting for a worker before requests are dropped")
+	flag.DurationVar(&backgroundTimeout, "background-timeout", fanout.DefaultBackgroundTimeout, "timeout of background requests")
+	flag.IntVar(&maxOutboundRequests, "max-outbound-requests", 0, "max concurrent requests to endpoints; 0 is unlimited")
+	flag.DurationVar(&outboxPollInterval, "outbox-poll-interval", outbox.DefaultPollInterval, "how often the outbox is polled for requests to deliver")
+	flag.IntVar(&outboxWorkers, "outbox-workers", outbox.DefaultWorkers, "max number of outbox requests delivered concurrently")
+	flag.DurationVar(&mismatchesFlushInterval, "mismatches-flush-interval", compare.DefaultFlushInterval, "how often the compared responses are added to the results shared by the cluster")
+	flag.StringVar(&authConfig, "auth-config", "", "JSON file with the authentication and the roles of the admin service callers")
+	flag.StringVar(&tlsCert, "tls-cert", "", "certificate file to serve TLS with")
+	flag.StringVar(&tlsKey, "tls-key", "", "private key file of the TLS certificate")
+	flag.StringVar(&tlsClientCA, "tls-client-ca", "", "CA certificates file to verify client certificates with")
+	flag.StringVar(&secretsKeyFile, "secrets-key-file", "", "file with the base64 encoded 32-byte master key of the secrets table")
+	flag.StringVar(&secretsDir, "secrets-dir", "", "directory of the files secret references can name; file references are disabled if empty")
+	flag.StringVar(&secretsEnvPrefix, "secrets-env-prefix", secrets.DefaultEnvPrefix, "prefix of the environment variables secret references can name; env references are disabled if empty")
+	flag.DurationVar(&secretsPollInterval, "secrets-poll-interval", secrets.DefaultPollInterval, "how often the referenced secrets are checked for changes")
 	flag.Parse()
 
+	if err := logging.Setup(os.Stderr, logging.Config{Level: logLevel, SampleRate: logSampleRate}); err != nil {
+		log.Fatalf("Failed to set up logging: %v", err)
+	}
+
 	if port := os.Getenv("PORT"); port != "" {
 		listen = ":" + port
 	}
-	conn, err := pgx.Connect(ctx, postgresConn)
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Config{
+		Exporter:     traceExporter,
+		OTLPEndpoint: traceOTLPEndpoint,
+		OTLPInsecure: traceOTLPInsecure,
+		File:         traceFile,
+		SampleRatio:  traceSampleRatio,
+	})
+	if err != nil {
+		log.Fatalf("Failed to set up tracing: %v", err)
+	}
+	defer shutdownTracing(ctx)
+
+	pool, err := pgxpool.New(ctx, postgresConn)
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
-	defer conn.Close(ctx)
+	defer pool.Close()
+
+	var masterKey []byte
+	if secretsKeyFile != "" {
+		if masterKey, err = secrets.LoadMasterKey(secretsKeyFile); err != nil {
+			log.Fatalf("Failed to load the secrets master key: %v", err)
+		}
+	}
+	secretStore, err := secrets.New(pool, masterKey, secrets.Policy{
+		EnvPrefix: secretsEnvPrefix,
+		Dir:       secretsDir,
+	})
+	if err != nil {
+		log.Fatalf("Failed to set up secrets: %v", err)
+	}
 
 	ccache := clientcache.New()
-	adminService := &adminService{pgConn: conn}
-	adminServer := pb.NewAdminServiceServer(adminService)
+	ccache.Secrets = secretStore
+	mismatches := compare.NewStore(compare.DefaultMaxSamples)
+	deliveries := outbox.New(pool, outbox.Options{
+		PollInterval: outboxPollInterval,
+		Workers:      outboxWorkers,
+	})
+	mismatchDB := compare.NewDB(pool, compare.DefaultMaxSamples)
+	go mismatchDB.Run(ctx, mismatches, mismatchesFlushInterval)
+	adminService := &adminService{pool: pool, mismatches: mismatchDB, outbox: deliveries, secrets: secretStore}
+	adminInterceptors := []twirp.Interceptor{logAdminRequests}
+	var authenticator auth.Authenticator
+	if authConfig != "" {
+		c, err := auth.LoadConfig(authConfig)
+		if err != nil {
+			log.Fatalf("Failed to load the auth config: %v", err)
+		}
+		authenticator, err = c.Authenticator()
+		if err != nil {
+			log.Fatalf("Failed to set up authentication: %v", err)
+		}
+		policy, err := c.Policy()
+		if err != nil {
+			log.Fatalf("Failed to set up authorization: %v", err)
+		}
+		adminInterceptors = append(adminInterceptors, authorizeAdminRequests(policy))
+	} else {
+		slog.Warn("The admin service is not authenticated, set -auth-config to authenticate it")
+	}
+	adminServer := pb.NewAdminServiceServer(adminService,
+		twirp.WithServerInterceptors(adminInterceptors...),
+	)
+	var peerURLs []string
+	if peers != "" {
+		peerURLs = strings.Split(peers, ",")
+	}
+	// The peers own the keys of the cache and the rate limits by
+	// their URLs, so this node has to know which of them it is.
+	switch {
+	case len(peerURLs) == 0 && self == "":
+		self = "http://" + listen
+		if tlsCert != "" {
+			self = "https://" + listen
+		}
+	case self == "":
+		log.Fatal("-peers requires -self")
+	case !slices.Contains(peerURLs, self):
+		log.Fatalf("-self %q is not in -peers", self)
+	}
 	fanoutCache := fanout.NewFanoutCache(
-		listen,
-		strings.Split(peers, ","),
+		self,
+		peerURLs,
 		ccache,
-		adminService,
+		fanoutLoader{adminService},
 		1*time.Minute,
 	)
+	metrics.RegisterCache("fanout", fanoutCache.Stats())
+	var peerToken string
+	if peerTokenFile != "" {
+		if peerToken, err = auth.LoadPeerToken(peerTokenFile); err != nil {
+			log.Fatalf("Failed to load the peer token: %v", err)
+		}
+		fanoutCache.SetTransport(auth.PeerTransport(peerToken, nil))
+	} else if len(peerURLs) > 0 {
+		log.Fatal("-peers requires -peer-token-file")
+	}
+
 	mux := mux.NewRouter()
-	mux.Handle("/_groupcache/", fanoutCache)
-	mux.Handle("/fanout/{name}", &fanout.Handler{
+	rateLimitOpts := ratelimit.Options{Lookup: fanoutCache.RateLimit}
+	if peerToken != "" {
+		rateLimitOpts.Transport = auth.PeerTransport(peerToken, nil)
+	}
+	rateLimiter := ratelimit.New(self, peerURLs, rateLimitOpts)
+
+	// The peers fetch the fanouts with their secrets from each other
+	// and take each other's rate limit tokens, so the peer routes are
+	// served only to the callers with the peer token.
+	if peerToken != "" {
+		mux.PathPrefix("/_groupcache/").Handler(auth.PeerMiddleware(peerToken, fanoutCache))
+		mux.PathPrefix(ratelimit.BasePath).Handler(auth.PeerMiddleware(peerToken, rateLimiter))
+	}
+	mux.Handle("/metrics", promhttp.Handler())
+	fanoutHandler := &fanout.Handler{
 		ClientCache: ccache,
 		FanoutCache: fanoutCache,
-	})
-	mux.PathPrefix(adminServer.PathPrefix()).Handler(adminServer)
+		Mismatches:  mismatches,
+		Background:  fanout.NewBackground(backgroundWorkers, backgroundQueue, backgroundTimeout),
+		Outbox:      deliveries,
+		RateLimiter: rateLimiter,
+		Secrets:     secretStore,
+		Body: tee.Options{
+			MemoryLimit: bodyMemoryLimit,
+			MaxSize:     bodyMaxSize,
+			Dir:         bodySpillDir,
+		},
+		MaxOutboundRequests: maxOutboundRequests,
+	}
+	go deliveries.Run(ctx, fanoutHandler.Deliver)
+	secretStore.OnChange(fanoutHandler.InvalidateSecret)
+	go secretStore.Run(ctx, secretsPollInterval)
 
-	log.Printf("Starting server at %v...", listen)
-	log.Fatal(http.ListenAndServe(listen, mux))
+	mux.Handle("/fanout/{name}", fanoutHandler)
+	mux.Handle("/fanout/{name}/{rest:.*}", fanoutHandler)
+	var adminHandler http.Handler = adminServer
+	if authenticator != nil {
+		adminHandler = auth.Middleware(authenticator, adminHandler)
+	}
+	mux.PathPrefix(adminServer.PathPrefix()).Handler(logging.Middleware(adminHandler))
+
+	server := &http.Server{Addr: listen, Handler: mux}
+	if tlsClientCA != "" {
+		if tlsCert == "" {
+			log.Fatal("-tls-client-ca requires -tls-cert")
+		}
+		pem, err := os.ReadFile(tlsClientCA)
+		if err != nil {
+			log.Fatalf("Failed to read the client CAs: %v", err)
+		}
+		cas := x509.NewCertPool()
+		if !cas.AppendCertsFromPEM(pem) {
+			log.Fatalf("No certificates found in %q", tlsClientCA)
+		}
+		// Client certificates are optional, callers can
+		// authenticate with tokens instead.
+		server.TLSConfig = &tls.Config{
+			ClientCAs:  cas,
+			ClientAuth: tls.VerifyClientCertIfGiven,
+		}
+	}
+
+	slog.Info("Starting server", "listen", listen, "tls", tlsCert != "")
+	if tlsCert != "" {
+		log.Fatal(server.ListenAndServeTLS(tlsCert, tlsKey))
+	}
+	log.Fatal(server.ListenAndServe())
 }
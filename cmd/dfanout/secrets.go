@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/dfanout/dfanout/secrets"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/proto"
+)
+
+var secretNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+func (s *adminService) SetSecret(ctx context.Context, req *pb.SetSecretRequest) (*pb.SetSecretResponse, error) {
+	if !secretNameRegexp.MatchString(req.Name) {
+		return nil, twirp.InvalidArgumentError("name", "should only have letters, digits, '_', '.' and '-'")
+	}
+	if len(req.Value) == 0 {
+		return nil, twirp.RequiredArgumentError("value")
+	}
+	if err := s.secrets.Set(ctx, req.Name, req.Value); err != nil {
+		return nil, err
+	}
+	return &pb.SetSecretResponse{}, nil
+}
+
+func (s *adminService) DeleteSecret(ctx context.Context, req *pb.DeleteSecretRequest) (*pb.DeleteSecretResponse, error) {
+	err := s.secrets.Delete(ctx, req.Name)
+	if errors.Is(err, secrets.ErrNotFound) {
+		return nil, twirp.NotFoundError(fmt.Sprintf("secret %q not found", req.Name))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteSecretResponse{}, nil
+}
+
+func (s *adminService) ListSecrets(ctx context.Context, req *pb.ListSecretsRequest) (*pb.ListSecretsResponse, error) {
+	infos, err := s.secrets.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListSecretsResponse{}
+	for _, i := range infos {
+		resp.Secrets = append(resp.Secrets, &pb.Secret{
+			Name:            i.Name,
+			CreatedAtUnixMs: i.CreatedAt.UnixMilli(),
+			UpdatedAtUnixMs: i.UpdatedAt.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+// fanoutLoader is the admin service of the fanout cache, which
+// needs the fanouts with their secret material to serve them.
+type fanoutLoader struct {
+	*adminService
+}
+
+func (l fanoutLoader) GetFanout(ctx context.Context, req *pb.GetFanoutRequest) (*pb.GetFanoutResponse, error) {
+	return l.loadFanout(ctx, req)
+}
+
+// redactFanout returns a copy of the fanout without TLS private keys,
+// HMAC secrets, URL passwords and the values of headers that carry
+// credentials. Secret references are kept.
+func redactFanout(resp *pb.GetFanoutResponse) *pb.GetFanoutResponse {
+	resp = proto.Clone(resp).(*pb.GetFanoutResponse)
+	for _, e := range resp.Endpoints {
+		switch d := e.Destination.(type) {
+		case *pb.Endpoint_HttpEndpoint:
+			d.HttpEndpoint.Url = secrets.RedactURL(d.HttpEndpoint.Url)
+			redactHeaders(d.HttpEndpoint.Header)
+			redactTLS(d.HttpEndpoint.TlsConfig)
+		case *pb.Endpoint_GrpcEndpoint:
+			redactHeaders(d.GrpcEndpoint.Metadata)
+			redactTLS(d.GrpcEndpoint.TlsConfig)
+		case *pb.Endpoint_TwirpEndpoint:
+			d.TwirpEndpoint.BaseUrl = secrets.RedactURL(d.TwirpEndpoint.BaseUrl)
+			redactHeaders(d.TwirpEndpoint.Header)
+			redactTLS(d.TwirpEndpoint.TlsConfig)
+		}
+		for _, op := range e.Transform.GetHeaders() {
+			redactHeaders([]*pb.Header{op.GetSet(), op.GetAdd()})
+		}
+		for _, op := range e.Transform.GetQuery() {
+			redactHeaders([]*pb.Header{op.GetSet(), op.GetAdd()})
+		}
+	}
+	for _, k := range resp.Config.GetInboundAuth().GetHmacKeys() {
+		if k.Secret != "" {
+			k.Secret = secrets.Redacted
+		}
+	}
+	return resp
+}
+
+func redactHeaders(headers []*pb.Header) {
+	for _, h := range headers {
+		if h == nil || !secrets.SensitiveHeader(h.Key) {
+			continue
+		}
+		for i := range h.Values {
+			h.Values[i] = secrets.Redacted
+		}
+	}
+}
+
+func redactTLS(c *pb.TLSConfig) {
+	if c != nil && len(c.KeyPem) > 0 {
+		c.KeyPem = []byte(secrets.Redacted)
+	}
+}
+
+// validateSecrets validates the endpoint's secret references, and
+// rejects the redacted values of GetFanout, e.g. if a fanout is read
+// and written back without setting its secrets again.
+func validateSecrets(e *pb.Endpoint) error {
+	var (
+		headers []*pb.Header
+		tls     *pb.TLSConfig
+		urls    []string
+	)
+	switch d := e.Destination.(type) {
+	case *pb.Endpoint_HttpEndpoint:
+		headers, tls, urls = d.HttpEndpoint.Header, d.HttpEndpoint.TlsConfig, []string{d.HttpEndpoint.Url}
+	case *pb.Endpoint_GrpcEndpoint:
+		headers, tls = d.GrpcEndpoint.Metadata, d.GrpcEndpoint.TlsConfig
+	case *pb.Endpoint_TwirpEndpoint:
+		headers, tls, urls = d.TwirpEndpoint.Header, d.TwirpEndpoint.TlsConfig, []string{d.TwirpEndpoint.BaseUrl}
+	}
+	for _, h := range headers {
+		if h.ValueRef != "" {
+			if err := secrets.Validate(h.ValueRef); err != nil {
+				return fmt.Errorf("header %q: %w", h.Key, err)
+			}
+		}
+		if redacted(h.Values) {
+			return fmt.Errorf("header %q has a redacted value, set the value or reference a secret", h.Key)
+		}
+	}
+	if tls != nil {
+		if tls.KeyPemRef != "" {
+			if len(tls.KeyPem) > 0 {
+				return errors.New("TLS config has both a private key and a private key reference")
+			}
+			if err := secrets.Validate(tls.KeyPemRef); err != nil {
+				return fmt.Errorf("TLS private key: %w", err)
+			}
+		}
+		if string(tls.KeyPem) == secrets.Redacted {
+			return errors.New("TLS config has a redacted private key, set the key or reference a secret")
+		}
+	}
+	for _, raw := range urls {
+		if u, err := url.Parse(raw); err == nil {
+			if p, ok := u.User.Password(); ok && p == secrets.Redacted {
+				return errors.New("URL has a redacted password, set the password")
+			}
+		}
+	}
+	var ops []*pb.Header
+	for _, op := range e.Transform.GetHeaders() {
+		ops = append(ops, op.GetSet(), op.GetAdd())
+	}
+	for _, op := range e.Transform.GetQuery() {
+		ops = append(ops, op.GetSet(), op.GetAdd())
+	}
+	for _, h := range ops {
+		if h == nil {
+			continue
+		}
+		if h.ValueRef != "" {
+			return fmt.Errorf("transform of %q can't reference a secret", h.Key)
+		}
+		if redacted(h.Values) {
+			return fmt.Errorf("transform of %q has a redacted value", h.Key)
+		}
+	}
+	return nil
+}
+
+// secretRefs returns the secret references of the endpoints
+// and the fanout config written by the request.
+func secretRefs(req any) []string {
+	var (
+		endpoints []*pb.Endpoint
+		config    *pb.FanoutConfig
+	)
+	switch r := req.(type) {
+	case *pb.CreateFanoutRequest:
+		endpoints, config = r.Endpoints, r.Config
+	case *pb.UpdateFanoutRequest:
+		endpoints, config = append(append(endpoints, r.EndpointsToInsert...), r.EndpointsToUpdate...), r.Config
+	}
+	var refs []string
+	for _, e := range endpoints {
+		var (
+			headers []*pb.Header
+			tls     *pb.TLSConfig
+		)
+		switch d := e.Destination.(type) {
+		case *pb.Endpoint_HttpEndpoint:
+			headers, tls = d.HttpEndpoint.Header, d.HttpEndpoint.TlsConfig
+		case *pb.Endpoint_GrpcEndpoint:
+			headers, tls = d.GrpcEndpoint.Metadata, d.GrpcEndpoint.TlsConfig
+		case *pb.Endpoint_TwirpEndpoint:
+			headers, tls = d.TwirpEndpoint.Header, d.TwirpEndpoint.TlsConfig
+		}
+		for _, h := range headers {
+			if h.ValueRef != "" {
+				refs = append(refs, h.ValueRef)
+			}
+		}
+		if ref := tls.GetKeyPemRef(); ref != "" {
+			refs = append(refs, ref)
+		}
+	}
+	for _, k := range config.GetInboundAuth().GetHmacKeys() {
+		if k.SecretRef != "" {
+			refs = append(refs, k.SecretRef)
+		}
+	}
+	return refs
+}
+
+// checkSecretRefs rejects the requests with secret references
+// the secrets policy doesn't allow.
+func (s *adminService) checkSecretRefs(req any) error {
+	for _, ref := range secretRefs(req) {
+		if err := s.secrets.Check(ref); err != nil {
+			return twirp.NewError(twirp.InvalidArgument, err.Error())
+		}
+	}
+	return nil
+}
+
+func redacted(values []string) bool {
+	for _, v := range values {
+		if v == secrets.Redacted {
+			return true
+		}
+	}
+	return false
+}
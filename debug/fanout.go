@@ -2,39 +2,110 @@ package debug
 
 import (
 	"fmt"
+	"html/template"
 	"net/http"
-	"text/template"
+	"strings"
 
 	pb "github.com/dfanout/dfanout/proto"
+	"github.com/dfanout/dfanout/secrets"
 )
 
 type Handler struct {
 	fanout    string
 	endpoints []*pb.Endpoint
+	status    map[string]Status
+	previews  map[string]*Preview
 }
 
-func NewHandler(fanout string, e []*pb.Endpoint) *Handler {
+// Preview is the request that would be sent to an endpoint.
+type Preview struct {
+	// Skipped is the reason the request wouldn't be sent, if any.
+	Skipped string
+
+	// Error is set if the request can't be created,
+	// e.g. the body can't be transformed.
+	Error string
+
+	Method string
+	URL    string
+	Header http.Header
+	Body   string
+}
+
+// Status is the runtime status of an endpoint on the serving node.
+type Status struct {
+	Requests  int64
+	Attempts  int64
+	Failures  int64
+	LastError string
+
+	// Compared and Mismatched are the number of responses compared
+	// to the primary response and the number of mismatches.
+	Compared   int64
+	Mismatched int64
+
+	// Breaker is the state of the endpoint's circuit
+	// breaker, or empty if it has no breaker.
+	Breaker string
+}
+
+func NewHandler(fanout string, e []*pb.Endpoint, status map[string]Status) *Handler {
 	return &Handler{
 		fanout:    fanout,
 		endpoints: e,
+		status:    status,
 	}
 }
 
+// NewPreviewHandler returns a handler that shows the requests
+// that would be sent to the endpoints along with the fanout.
+func NewPreviewHandler(fanout string, e []*pb.Endpoint, status map[string]Status, previews map[string]*Preview) *Handler {
+	h := NewHandler(fanout, e, status)
+	h.previews = previews
+	return h
+}
+
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	var endpoints []endpointData
 	for _, e := range h.endpoints {
-		httpEndpoint := e.Destination.(*pb.Endpoint_HttpEndpoint).HttpEndpoint
-		endpoints = append(endpoints, endpointData{
-			Name:    e.Name,
-			Primary: e.Primary,
-			URL:     httpEndpoint.Url,
-			Method:  httpEndpoint.Method,
-			Timeout: httpEndpoint.TimeoutMs,
-		})
+		data := endpointData{
+			Name:        e.Name,
+			Primary:     e.Primary,
+			FailureMode: failureModes[e.FailureMode],
+			MaxAttempts: e.RetryPolicy.GetMaxAttempts(),
+			Sampling:    sampling(e.Sampling),
+			MatchRules:  matchRules(e.MatchRules),
+			Transform:   transform(e.Transform),
+			RateLimit:   rateLimit(e),
+			Preview:     h.previews[e.Name],
+			Status:      h.status[e.Name],
+		}
+		switch d := e.Destination.(type) {
+		case *pb.Endpoint_HttpEndpoint:
+			data.Type = "HTTP"
+			data.URL = secrets.RedactURL(d.HttpEndpoint.Url)
+			data.Method = d.HttpEndpoint.Method
+			data.Timeout = d.HttpEndpoint.TimeoutMs
+		case *pb.Endpoint_GrpcEndpoint:
+			data.Type = "gRPC"
+			data.URL = d.GrpcEndpoint.Target
+			data.Method = d.GrpcEndpoint.Method
+			data.Timeout = d.GrpcEndpoint.TimeoutMs
+		case *pb.Endpoint_TwirpEndpoint:
+			data.Type = "Twirp"
+			data.URL = secrets.RedactURL(d.TwirpEndpoint.BaseUrl)
+			data.Method = d.TwirpEndpoint.Service + "/" + d.TwirpEndpoint.Method
+			data.Timeout = d.TwirpEndpoint.TimeoutMs
+		case *pb.Endpoint_FanoutEndpoint:
+			data.Type = "Fanout"
+			data.URL = "/fanout/" + d.FanoutEndpoint.Fanout
+		}
+		endpoints = append(endpoints, data)
 	}
 	if err := debugTmpl.Execute(w, &debugData{
 		Fanout:    h.fanout,
 		Endpoints: endpoints,
+		Preview:   h.previews != nil,
 	}); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		fmt.Fprintf(w, "failed to render the page: %v", err)
@@ -44,14 +115,132 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 type endpointData struct {
 	Name    string
 	Primary bool
+	Type    string
 	URL     string
 	Method  string
 	Timeout int64
+
+	FailureMode string
+	MaxAttempts int32
+	Sampling    string
+	MatchRules  []string
+	Transform   string
+	RateLimit   string
+	Status      Status
+	Preview     *Preview
+}
+
+// transform summarizes the transform, e.g. "2 header, 1 body operations".
+func transform(t *pb.Transform) string {
+	var ops []string
+	if n := len(t.GetHeaders()); n > 0 {
+		ops = append(ops, fmt.Sprintf("%d header", n))
+	}
+	if n := len(t.GetQuery()); n > 0 {
+		ops = append(ops, fmt.Sprintf("%d query", n))
+	}
+	if n := len(t.GetBody()); n > 0 {
+		ops = append(ops, fmt.Sprintf("%d body", n))
+	}
+	if len(ops) == 0 {
+		return ""
+	}
+	return strings.Join(ops, ", ") + " operations"
+}
+
+// matchRules formats the rules, e.g.
+// "method POST or PUT, header X-Tenant=acme, path ~ /orders$".
+func matchRules(rules []*pb.MatchRule) []string {
+	var formatted []string
+	for _, rule := range rules {
+		var conds []string
+		if len(rule.Methods) > 0 {
+			conds = append(conds, "method "+strings.Join(rule.Methods, " or "))
+		}
+		for _, h := range rule.Headers {
+			conds = append(conds, "header "+condition(h.Name, h.Value))
+		}
+		for _, q := range rule.QueryParams {
+			conds = append(conds, "query "+condition(q.Name, q.Value))
+		}
+		if rule.PathSuffixRegex != "" {
+			conds = append(conds, "path ~ "+rule.PathSuffixRegex+"$")
+		}
+		if len(conds) == 0 {
+			conds = append(conds, "any request")
+		}
+		formatted = append(formatted, strings.Join(conds, ", "))
+	}
+	return formatted
+}
+
+func condition(name, value string) string {
+	if value == "" {
+		return name + " is present"
+	}
+	return name + "=" + value
+}
+
+// rateLimit summarizes the endpoint's outbound rate limit,
+// e.g. "10 rps, burst 20, 4 in flight, over limit drop".
+func rateLimit(e *pb.Endpoint) string {
+	c := e.OutboundRateLimit
+	var limits []string
+	if c.GetRequestsPerSecond() > 0 {
+		limits = append(limits, fmt.Sprintf("%v rps", c.RequestsPerSecond))
+		if c.Burst > 0 {
+			limits = append(limits, fmt.Sprintf("burst %d", c.Burst))
+		}
+	}
+	if c.GetMaxInFlight() > 0 {
+		limits = append(limits, fmt.Sprintf("%d in flight", c.MaxInFlight))
+	}
+	if len(limits) == 0 {
+		return ""
+	}
+	action, ok := overLimitActions[c.Action]
+	if !ok {
+		action = "drop"
+		if e.Primary {
+			action = "fail"
+		}
+	}
+	return strings.Join(limits, ", ") + ", over limit " + action
+}
+
+var overLimitActions = map[pb.OverLimitAction]string{
+	pb.OverLimitAction_OVER_LIMIT_ACTION_DROP:  "drop",
+	pb.OverLimitAction_OVER_LIMIT_ACTION_DEFER: "defer",
+	pb.OverLimitAction_OVER_LIMIT_ACTION_WAIT:  "wait",
+	pb.OverLimitAction_OVER_LIMIT_ACTION_FAIL:  "fail",
+}
+
+func sampling(s *pb.Sampling) string {
+	if s == nil {
+		return ""
+	}
+	v := fmt.Sprintf("%v%%", s.Percentage)
+	switch k := s.Key.(type) {
+	case *pb.Sampling_Header:
+		v += " by header " + k.Header
+	case *pb.Sampling_QueryParam:
+		v += " by query param " + k.QueryParam
+	case *pb.Sampling_JsonField:
+		v += " by JSON field " + k.JsonField
+	}
+	return v
+}
+
+var failureModes = map[pb.FailureMode]string{
+	pb.FailureMode_FAILURE_MODE_BEST_EFFORT: "best effort",
+	pb.FailureMode_FAILURE_MODE_REQUIRED:    "required",
+	pb.FailureMode_FAILURE_MODE_QUORUM:      "quorum",
 }
 
 type debugData struct {
 	Fanout    string
 	Endpoints []endpointData
+	Preview   bool
 }
 
 var debugTmpl = template.Must(template.New("debug").Parse(debugHTML))
@@ -213,6 +402,12 @@ body, html {
 a {
 	color: #4284CA;
 }
+pre {
+	max-width: 480px;
+	overflow-x: auto;
+	white-space: pre-wrap;
+	word-break: break-all;
+}
 </style>
 
 </head>
@@ -222,6 +417,9 @@ a {
 	<div class="blocktext">
 		<p class="blocktitle">{{.Fanout}}</p>
 		<p class="blockdesc"><a href="/fanout/{{.Fanout}}">/fanout/{{.Fanout}}</a></p>
+		{{if not .Preview}}
+		<p class="blockdesc">Send a request with <a href="/fanout/{{.Fanout}}?debug=preview">?debug=preview</a> to preview the requests sent to the endpoints.</p>
+		{{end}}
 	</div>
 </div>
 
@@ -231,12 +429,65 @@ a {
 		<div class="blockyleft"><p class="blockyname">{{$e.Name}}{{if $e.Primary}} <span class="primary-text">primary</span>{{end}}</p></div>
 		<div class="blockydiv"></div>
 		<div class="blockyinfo">
-			<span>URL</span> {{$e.URL}}
+			<span>Type</span> {{$e.Type}}
+			<br>
+			{{if eq $e.Type "Fanout"}}
+			<span>Fanout</span> <a href="{{$e.URL}}?debug">{{$e.URL}}</a>
+			{{else}}
+			<span>{{if eq $e.Type "gRPC"}}Target{{else}}URL{{end}}</span> {{$e.URL}}
+			{{end}}
 			<br>
 			<span>Method</span> {{$e.Method}}
 			<br>
 			<span>Timeout</span>{{if (gt $e.Timeout 0)}} {{$e.Timeout}}ms {{else}} default {{end}}
-			
+			<br>
+			{{if not $e.Primary}}
+			<span>Failure mode</span> {{$e.FailureMode}}
+			<br>
+			{{end}}
+			{{range $e.MatchRules}}
+			<span>Match</span> {{.}}
+			<br>
+			{{end}}
+			{{if $e.Sampling}}
+			<span>Sampling</span> {{$e.Sampling}}
+			<br>
+			{{end}}
+			{{if $e.Transform}}
+			<span>Transform</span> {{$e.Transform}}
+			<br>
+			{{end}}
+			{{if $e.RateLimit}}
+			<span>Rate limit</span> {{$e.RateLimit}}
+			<br>
+			{{end}}
+			<span>Retries</span>{{if (gt $e.MaxAttempts 1)}} up to {{$e.MaxAttempts}} attempts {{else}} none {{end}}
+			<br>
+			{{if $e.Status.Breaker}}
+			<span>Circuit breaker</span> {{$e.Status.Breaker}}
+			<br>
+			{{end}}
+			<span>Requests</span> {{$e.Status.Requests}} ({{$e.Status.Attempts}} attempts, {{$e.Status.Failures}} failures)
+			{{if (gt $e.Status.Compared 0)}}
+			<br>
+			<span>Mismatches</span> {{$e.Status.Mismatched}} of {{$e.Status.Compared}} compared responses
+			{{end}}
+			{{if $e.Status.LastError}}
+			<br>
+			<span>Last error</span> {{$e.Status.LastError}}
+			{{end}}
+			{{with $e.Preview}}
+			<br>
+			<span>Preview</span>
+			{{if .Skipped}} skipped, {{.Skipped}}
+			{{else if .Error}} {{.Error}}
+			{{else}}
+<pre>{{.Method}} {{.URL}}
+{{range $k, $vv := .Header}}{{range $vv}}{{$k}}: {{.}}
+{{end}}{{end}}
+{{.Body}}</pre>
+			{{end}}
+			{{end}}
 		</div>
 	</div>
 {{end}}
@@ -20,8 +20,9 @@ func main() {
 		Primary: true,
 		Destination: &pb.Endpoint_HttpEndpoint{
 			HttpEndpoint: &pb.HTTPEndpoint{
-				Url:    "https://api-server:8080/test", // allow url templates
-				Method: "GET",
+				Url:         "https://api-server:8080/test",
+				Method:      "GET",
+				ForwardPath: true,
 				TlsConfig: &pb.TLSConfig{
 					InsecureSkipVerify: true,
 				},
@@ -32,7 +33,8 @@ func main() {
 		Name: "read_likes_v2",
 		Destination: &pb.Endpoint_HttpEndpoint{
 			HttpEndpoint: &pb.HTTPEndpoint{
-				Url:    "https://api-server:8080/test2",
+				// Templates can use the inbound path, query params and headers.
+				Url:    "https://api-server:8080/test2/{{.Path}}?user={{.Header.Get \"X-User\" | queryescape}}",
 				Method: "GET",
 				Header: []*pb.Header{
 					{Key: "X-Extra", Values: []string{"v2"}},
@@ -0,0 +1,142 @@
+package fanout
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/dfanout/dfanout/fanout/merge"
+	pb "github.com/dfanout/dfanout/proto"
+)
+
+const defaultMaxAggregateBytes = 4 << 20 // 4 MB
+
+// aggregating reports whether the fan serves the
+// merged responses of the endpoints.
+func (worker *Worker) aggregating() bool {
+	return worker.config.GetMode() == pb.FanoutMode_FANOUT_MODE_AGGREGATE
+}
+
+// aggregated reports whether the endpoint's response is merged.
+func (worker *Worker) aggregated(endpoint *pb.Endpoint) bool {
+	if !worker.aggregating() {
+		return false
+	}
+	names := worker.config.GetAggregate().GetEndpoints()
+	return len(names) == 0 || contains(names, endpoint.Name)
+}
+
+// readAggregated reads the response body to merge.
+func (worker *Worker) readAggregated(resp *workerResponse) ([]byte, error) {
+	if resp.twerr != nil {
+		return nil, fmt.Errorf("twirp error %q", resp.twerr.Code())
+	}
+	if resp.body == nil {
+		return nil, errors.New("empty response")
+	}
+	max := int64(defaultMaxAggregateBytes)
+	if v := worker.config.GetAggregate().GetMaxResponseBytes(); v > 0 {
+		max = v
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.body, max+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > max {
+		return nil, fmt.Errorf("response is larger than %d bytes", max)
+	}
+	return body, nil
+}
+
+// writeAggregate merges the responses of the aggregated
+// endpoints and serves the merged document.
+func (worker *Worker) writeAggregate(w http.ResponseWriter, results []endpointResult) {
+	config := worker.config.GetAggregate()
+	errs := make(map[string]string)
+	var docs []merge.Document
+	for _, i := range worker.aggregateOrder() {
+		e, result := worker.endpoints[i], results[i]
+		switch {
+		case result.skipped:
+			continue
+		case result.failed() != "":
+			errs[e.Name] = result.failed()
+		case result.bodyErr != nil:
+			errs[e.Name] = result.bodyErr.Error()
+		default:
+			docs = append(docs, merge.Document{Name: e.Name, Body: result.body})
+		}
+	}
+	if len(docs) == 0 && len(errs) > 0 {
+		writeFailures(w, sortedFailures(errs))
+		return
+	}
+
+	var (
+		body []byte
+		err  error
+	)
+	switch config.GetStrategy() {
+	case pb.AggregateStrategy_AGGREGATE_STRATEGY_DEEP_MERGE:
+		body, err = merge.Deep(docs, conflictPolicies[config.GetConflictPolicy()], errs)
+	default:
+		body, err = merge.ByName(docs, errs)
+	}
+	var conflict *merge.ConflictError
+	if errors.As(err, &conflict) {
+		writeFailures(w, []endpointFailure{
+			{Endpoint: conflict.Endpoints[0], Reason: conflict.Error()},
+			{Endpoint: conflict.Endpoints[1], Reason: conflict.Error()},
+		})
+		return
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "failed to merge the responses: %v", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+var conflictPolicies = map[pb.ConflictPolicy]merge.Conflict{
+	pb.ConflictPolicy_CONFLICT_POLICY_FIRST_WINS: merge.FirstWins,
+	pb.ConflictPolicy_CONFLICT_POLICY_LAST_WINS:  merge.LastWins,
+	pb.ConflictPolicy_CONFLICT_POLICY_FAIL:       merge.Fail,
+}
+
+// aggregateOrder returns the indexes of the
+// aggregated endpoints in merge order.
+func (worker *Worker) aggregateOrder() []int {
+	names := worker.config.GetAggregate().GetEndpoints()
+	if len(names) == 0 {
+		order := make([]int, len(worker.endpoints))
+		for i := range order {
+			order[i] = i
+		}
+		return order
+	}
+	var order []int
+	for _, name := range names {
+		for i, e := range worker.endpoints {
+			if e.Name == name {
+				order = append(order, i)
+			}
+		}
+	}
+	return order
+}
+
+func sortedFailures(errs map[string]string) []endpointFailure {
+	failures := make([]endpointFailure, 0, len(errs))
+	for name, reason := range errs {
+		failures = append(failures, endpointFailure{Endpoint: name, Reason: reason})
+	}
+	sort.Slice(failures, func(i, j int) bool {
+		return failures[i].Endpoint < failures[j].Endpoint
+	})
+	return failures
+}
@@ -0,0 +1,227 @@
+package fanout
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dfanout/dfanout/auth"
+	"github.com/dfanout/dfanout/fanout/tee"
+	"github.com/dfanout/dfanout/logging"
+	"github.com/dfanout/dfanout/metrics"
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/dfanout/dfanout/secrets"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultAPIKeyHeader is the default header of API keys.
+const defaultAPIKeyHeader = "X-API-Key"
+
+// authenticators keeps the inbound authenticators of the fanouts.
+type authenticators struct {
+	m sync.Map // fanout -> *authenticatorEntry
+}
+
+type authenticatorEntry struct {
+	config *pb.InboundAuth
+
+	once          sync.Once
+	authenticator auth.Authenticator
+	err           error
+}
+
+// get returns the authenticator of the fanout, or nil if its callers
+// aren't authenticated. Authenticators are rebuilt when their config
+// changes. Each authenticator is built once, without blocking the
+// other fanouts. Secrets that can't be resolved are retried on the
+// next call.
+func (a *authenticators) get(ctx context.Context, fanout string, c *pb.InboundAuth, store *secrets.Store) (auth.Authenticator, error) {
+	if !authenticating(c) {
+		return nil, nil
+	}
+	v, ok := a.m.Load(fanout)
+	if !ok || !proto.Equal(v.(*authenticatorEntry).config, c) {
+		// Concurrent calls may build the same authenticator, the last
+		// one is kept.
+		v = &authenticatorEntry{config: c}
+		a.m.Store(fanout, v)
+	}
+	e := v.(*authenticatorEntry)
+	e.once.Do(func() {
+		e.authenticator, e.err = newAuthenticator(ctx, c, store)
+		if errors.Is(e.err, errSecret) {
+			a.m.CompareAndDelete(fanout, e)
+		}
+	})
+	return e.authenticator, e.err
+}
+
+// reset drops the authenticators, so they are built again.
+func (a *authenticators) reset() {
+	a.m.Range(func(k, _ any) bool {
+		a.m.Delete(k)
+		return true
+	})
+}
+
+// authenticating reports whether the config has authentication methods.
+func authenticating(c *pb.InboundAuth) bool {
+	return len(c.GetApiKeys()) > 0 || len(c.GetHmacKeys()) > 0 || c.GetJwt() != nil || c.GetMtls() != nil
+}
+
+// errSecret is returned when a secret of the config can't be resolved.
+var errSecret = errors.New("cannot resolve the secret")
+
+func newAuthenticator(ctx context.Context, c *pb.InboundAuth, store *secrets.Store) (auth.Authenticator, error) {
+	var chain auth.Chain
+	if len(c.ApiKeys) > 0 {
+		tokens := make([]auth.Token, 0, len(c.ApiKeys))
+		for _, k := range c.ApiKeys {
+			tokens = append(tokens, auth.Token{Subject: k.Name, SHA256: k.Sha256})
+		}
+		t, err := auth.NewHeaderTokens(apiKeyHeader(c), tokens)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, t)
+	}
+	if len(c.HmacKeys) > 0 {
+		keys := make(map[string][]byte, len(c.HmacKeys))
+		for _, k := range c.HmacKeys {
+			keys[k.Id] = []byte(k.Secret)
+			if k.SecretRef != "" {
+				v, err := store.Resolve(ctx, k.SecretRef)
+				if err != nil {
+					return nil, fmt.Errorf("%w of HMAC key %q: %v", errSecret, k.Id, err)
+				}
+				keys[k.Id] = v
+			}
+		}
+		chain = append(chain, auth.NewHMAC(keys, time.Duration(c.HmacMaxSkewMs)*time.Millisecond))
+	}
+	if j := c.Jwt; j != nil {
+		a, err := auth.NewJWTWithKeys([]byte(j.Jwks), j.Issuer, j.Audience)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, a)
+	}
+	if m := c.Mtls; m != nil {
+		chain = append(chain, auth.MTLS{Allowed: m.AllowedSubjects})
+	}
+	return chain, nil
+}
+
+func apiKeyHeader(c *pb.InboundAuth) string {
+	if h := c.GetApiKeyHeader(); h != "" {
+		return h
+	}
+	return defaultAPIKeyHeader
+}
+
+type callerKey struct{}
+
+// caller is the original request of the fanouts called by other
+// fanouts in process. The caller's credentials are removed from the
+// requests to fanout endpoints, so the called fanouts authenticate
+// the original request instead.
+type caller struct {
+	r *http.Request
+
+	// body is the body of a signed request, if it is buffered in
+	// memory. Otherwise, called fanouts can't verify the signature.
+	body []byte
+}
+
+// authenticate authenticates the caller of the fanout, and returns the
+// request with the caller's identity in its context. HMAC signatures
+// are verified against the buffered body, or an empty body if body is
+// nil. Unauthenticated requests are responded with 401, and nil is
+// returned. Fanouts called by other fanouts in process authenticate
+// the credentials of the original caller, with their own config.
+func (h *Handler) authenticate(w http.ResponseWriter, r *http.Request, fanout string, c *pb.InboundAuth, body *tee.Body) *http.Request {
+	ctx := r.Context()
+	cl, nested := ctx.Value(callerKey{}).(*caller)
+	if nested = nested && len(fanoutChain(ctx)) > 0; !nested {
+		cl = &caller{r: r}
+		if body != nil && r.Header.Get(auth.SignatureHeader) != "" {
+			cl.body, _ = body.Bytes()
+		}
+		ctx = context.WithValue(ctx, callerKey{}, cl)
+	}
+	r = r.WithContext(ctx)
+
+	a, err := h.authenticators.get(ctx, fanout, c, h.Secrets)
+	if err != nil {
+		logging.FromContext(r.Context()).Error("Invalid inbound auth config", logging.Fanout, fanout, logging.Error, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintln(w, "invalid inbound auth config")
+		return nil
+	}
+	if a == nil {
+		return r
+	}
+
+	authReq := cl.r.WithContext(ctx)
+	authReq.Body = http.NoBody
+	switch {
+	case !nested && body != nil:
+		authReq.Body = io.NopCloser(body.NewReader())
+	case nested && cl.body != nil:
+		authReq.Body = io.NopCloser(bytes.NewReader(cl.body))
+	}
+	id, err := a.Authenticate(authReq)
+	if err != nil {
+		metrics.InboundUnauthenticated.WithLabelValues(fanout).Inc()
+		logging.FromContext(r.Context()).Info("Rejected an unauthenticated request", logging.Fanout, fanout, logging.Error, err)
+		if errors.Is(err, auth.ErrNoCredentials) {
+			err = errors.New("missing credentials")
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprintf(w, "unauthenticated: %v\n", err)
+		return nil
+	}
+	return r.WithContext(auth.NewContext(r.Context(), id))
+}
+
+// callerHeaders returns the request to the endpoint with the caller's
+// identity headers, and without the caller's credentials unless the
+// endpoint opts in to receive them.
+func (worker *Worker) callerHeaders(r *http.Request, endpoint *pb.Endpoint) *http.Request {
+	c := worker.config.GetInboundAuth()
+	if c == nil {
+		return r
+	}
+	r = r.Clone(r.Context())
+	if !endpoint.ForwardCredentials {
+		if len(c.ApiKeys) > 0 {
+			r.Header.Del(apiKeyHeader(c))
+		}
+		if len(c.HmacKeys) > 0 {
+			r.Header.Del(auth.SignatureHeader)
+		}
+		if c.Jwt != nil {
+			r.Header.Del("Authorization")
+		}
+	}
+	// Callers can't claim an identity.
+	for _, name := range []string{c.SubjectHeader, c.MethodHeader} {
+		if name != "" {
+			r.Header.Del(name)
+		}
+	}
+	if id, err := auth.FromContext(r.Context()); err == nil {
+		if c.SubjectHeader != "" {
+			r.Header.Set(c.SubjectHeader, id.Subject)
+		}
+		if c.MethodHeader != "" {
+			r.Header.Set(c.MethodHeader, id.Method)
+		}
+	}
+	return r
+}
@@ -0,0 +1,199 @@
+package fanout
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dfanout/dfanout/auth"
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestCallerHeaders(t *testing.T) {
+	full := &pb.InboundAuth{
+		ApiKeys:       []*pb.APIKey{{Name: "a", Sha256: "00"}},
+		ApiKeyHeader:  "X-Key",
+		HmacKeys:      []*pb.HMACKey{{Id: "h", Secret: "secret"}},
+		Jwt:           &pb.JWTAuth{Jwks: "{}"},
+		SubjectHeader: "X-Subject",
+		MethodHeader:  "X-Auth-Method",
+	}
+	inbound := http.Header{
+		"Authorization":       {"Bearer jwt"},
+		"X-Key":               {"api-key"},
+		"X-Signature":         {"key_id=h"},
+		"X-Subject":           {"admin"},
+		"X-Auth-Method":       {"mtls"},
+		"X-Request-Id":        {"1"},
+		"X-Api-Key":           {"other"},
+		"Proxy-Authorization": {"Basic x"},
+	}
+	alice := &auth.Identity{Subject: "alice", Method: "jwt"}
+	tests := []struct {
+		name     string
+		config   *pb.InboundAuth
+		forward  bool
+		identity *auth.Identity
+		want     http.Header
+	}{
+		{
+			name: "no inbound auth",
+			want: inbound,
+		},
+		{
+			name:     "credentials and claimed identities are removed",
+			config:   full,
+			identity: alice,
+			want: http.Header{
+				"X-Subject":           {"alice"},
+				"X-Auth-Method":       {"jwt"},
+				"X-Request-Id":        {"1"},
+				"X-Api-Key":           {"other"},
+				"Proxy-Authorization": {"Basic x"},
+			},
+		},
+		{
+			name:     "forwarded credentials",
+			config:   full,
+			forward:  true,
+			identity: alice,
+			want: http.Header{
+				"Authorization":       {"Bearer jwt"},
+				"X-Key":               {"api-key"},
+				"X-Signature":         {"key_id=h"},
+				"X-Subject":           {"alice"},
+				"X-Auth-Method":       {"jwt"},
+				"X-Request-Id":        {"1"},
+				"X-Api-Key":           {"other"},
+				"Proxy-Authorization": {"Basic x"},
+			},
+		},
+		{
+			name:   "unauthenticated callers can't claim an identity",
+			config: full,
+			want: http.Header{
+				"X-Request-Id":        {"1"},
+				"X-Api-Key":           {"other"},
+				"Proxy-Authorization": {"Basic x"},
+			},
+		},
+		{
+			name:     "only the configured methods' credentials are removed",
+			config:   &pb.InboundAuth{ApiKeys: []*pb.APIKey{{Name: "a", Sha256: "00"}}},
+			identity: &auth.Identity{Subject: "a", Method: "api_key"},
+			want: http.Header{
+				"Authorization":       {"Bearer jwt"},
+				"X-Key":               {"api-key"},
+				"X-Signature":         {"key_id=h"},
+				"X-Subject":           {"admin"},
+				"X-Auth-Method":       {"mtls"},
+				"X-Request-Id":        {"1"},
+				"Proxy-Authorization": {"Basic x"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/fanout/f", nil)
+			r.Header = inbound.Clone()
+			if tt.identity != nil {
+				r = r.WithContext(auth.NewContext(r.Context(), tt.identity))
+			}
+			worker := &Worker{config: &pb.FanoutConfig{InboundAuth: tt.config}}
+			got := worker.callerHeaders(r, &pb.Endpoint{Name: "e", ForwardCredentials: tt.forward})
+			if !reflect.DeepEqual(got.Header, tt.want) {
+				t.Errorf("callerHeaders() = %v, want %v", got.Header, tt.want)
+			}
+			if !reflect.DeepEqual(r.Header, inbound) {
+				t.Errorf("callerHeaders() modified the inbound headers: %v", r.Header)
+			}
+		})
+	}
+}
+
+func TestAuthenticatorsRefresh(t *testing.T) {
+	jwks := func() (string, ed25519.PrivateKey) {
+		pub, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return `{"keys":[{"kty":"OKP","kid":"k","crv":"Ed25519","x":"` + base64.RawURLEncoding.EncodeToString(pub) + `"}]}`, key
+	}
+	authenticate := func(a auth.Authenticator, key ed25519.PrivateKey) error {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.RegisteredClaims{
+			Subject:   "alice",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		}).SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := httptest.NewRequest(http.MethodGet, "/fanout/f", nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+		_, err = a.Authenticate(r)
+		return err
+	}
+
+	oldJWKS, oldKey := jwks()
+	newJWKS, newKey := jwks()
+	var a authenticators
+	ctx := context.Background()
+
+	if got, err := a.get(ctx, "f", &pb.InboundAuth{}, nil); got != nil || err != nil {
+		t.Errorf("get() without auth methods = %v, %v, want nil", got, err)
+	}
+	first, err := a.get(ctx, "f", &pb.InboundAuth{Jwt: &pb.JWTAuth{Jwks: oldJWKS}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := authenticate(first, oldKey); err != nil {
+		t.Errorf("token of the old key = %v", err)
+	}
+	same, err := a.get(ctx, "f", &pb.InboundAuth{Jwt: &pb.JWTAuth{Jwks: oldJWKS}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := authenticate(same, newKey); err == nil {
+		t.Error("token of the new key is accepted before the JWKS changes")
+	}
+
+	// A new JWKS rebuilds the authenticator.
+	rotated, err := a.get(ctx, "f", &pb.InboundAuth{Jwt: &pb.JWTAuth{Jwks: newJWKS}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := authenticate(rotated, newKey); err != nil {
+		t.Errorf("token of the new key after the JWKS changed = %v", err)
+	}
+	if err := authenticate(rotated, oldKey); err == nil {
+		t.Error("token of the old key is accepted after the JWKS changed")
+	}
+
+	// Invalid JWKS are rejected.
+	if _, err := a.get(ctx, "g", &pb.InboundAuth{Jwt: &pb.JWTAuth{Jwks: `{"keys":[]}`}}, nil); err == nil {
+		t.Error("get() with an invalid JWKS = nil, want an error")
+	}
+
+	// Unresolved secrets are retried.
+	c := &pb.InboundAuth{HmacKeys: []*pb.HMACKey{{Id: "h", SecretRef: "db:hmac"}}}
+	for i := 0; i < 2; i++ {
+		if _, err := a.get(ctx, "h", c, nil); !errors.Is(err, errSecret) {
+			t.Errorf("get() %d with an unresolved secret = %v, want errSecret", i, err)
+		}
+		if _, ok := a.m.Load("h"); ok {
+			t.Errorf("get() %d kept the authenticator with an unresolved secret", i)
+		}
+	}
+
+	a.reset()
+	if _, ok := a.m.Load("f"); ok {
+		t.Error("reset() kept the authenticators")
+	}
+}
@@ -0,0 +1,99 @@
+package fanout
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dfanout/dfanout/logging"
+	"github.com/dfanout/dfanout/metrics"
+	pb "github.com/dfanout/dfanout/proto"
+)
+
+const (
+	DefaultBackgroundWorkers = 64
+	DefaultBackgroundQueue   = 1024
+	DefaultBackgroundTimeout = 30 * time.Second
+)
+
+// Background is a bounded pool that sends requests to the
+// endpoints fans don't wait for. Requests are detached from
+// the inbound request and have their own deadline.
+type Background struct {
+	tasks   chan func()
+	timeout time.Duration
+}
+
+// NewBackground starts a pool with the given number of workers.
+// Up to queue requests wait for a worker, further requests are
+// dropped. Each request times out after timeout.
+func NewBackground(workers, queue int, timeout time.Duration) *Background {
+	if workers <= 0 {
+		workers = DefaultBackgroundWorkers
+	}
+	if queue < 0 {
+		queue = 0
+	}
+	if timeout <= 0 {
+		timeout = DefaultBackgroundTimeout
+	}
+	b := &Background{
+		tasks:   make(chan func(), queue),
+		timeout: timeout,
+	}
+	for i := 0; i < workers; i++ {
+		go func() {
+			for task := range b.tasks {
+				task()
+			}
+		}()
+	}
+	return b
+}
+
+// submit queues the task. It reports false if the queue is full.
+func (b *Background) submit(task func()) bool {
+	select {
+	case b.tasks <- task:
+		return true
+	default:
+		return false
+	}
+}
+
+// detached reports whether the endpoint is sent requests in the
+// background. Endpoints that decide the fan's outcome are waited for.
+func (worker *Worker) detached(endpoint *pb.Endpoint) bool {
+	if worker.handler.Background == nil || worker.config.GetWaitForAll() {
+		return false
+	}
+	if endpoint.Primary || endpoint.FailureMode != pb.FailureMode_FAILURE_MODE_BEST_EFFORT {
+		return false
+	}
+	return !worker.aggregated(endpoint)
+}
+
+// detach sends a request to the endpoint in the background and
+// records its result in results[i] once done. The request keeps
+// the inbound request's values, but not its cancellation or deadline.
+func (worker *Worker) detach(r *http.Request, i int, endpoint *pb.Endpoint, wg *sync.WaitGroup, results []endpointResult) {
+	background := worker.handler.Background
+	wg.Add(1)
+	worker.body.Acquire()
+	ok := background.submit(func() {
+		defer wg.Done()
+		defer worker.body.Close()
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), background.timeout)
+		defer cancel()
+		results[i] = worker.do(r.WithContext(ctx), worker.fanout, endpoint)
+	})
+	if !ok {
+		worker.body.Close()
+		wg.Done()
+		results[i].skipped = true
+		metrics.BackgroundDropped.WithLabelValues(worker.fanout, endpoint.Name).Inc()
+		worker.logger.Warn("Dropped the background request; the queue is full", logging.Endpoint, endpoint.Name)
+	}
+}
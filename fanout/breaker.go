@@ -0,0 +1,89 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dfanout/dfanout/fanout/breaker"
+	"github.com/dfanout/dfanout/metrics"
+	pb "github.com/dfanout/dfanout/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+var errCircuitOpen = errors.New("circuit breaker is open")
+
+// breakers keeps the circuit breakers of endpoints on this node.
+type breakers struct {
+	mu sync.Mutex
+	m  map[statsKey]*breakerEntry
+}
+
+type breakerEntry struct {
+	config  *pb.CircuitBreaker
+	breaker *breaker.Breaker
+}
+
+// get returns the breaker of the endpoint, or nil if the endpoint
+// has no breaker. Breakers are reset when their config changes.
+func (b *breakers) get(fanout string, endpoint *pb.Endpoint) *breaker.Breaker {
+	c := endpoint.CircuitBreaker
+	if !c.GetEnabled() {
+		return nil
+	}
+	key := statsKey{fanout: fanout, endpoint: endpoint.Name}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if e, ok := b.m[key]; ok && proto.Equal(e.config, c) {
+		return e.breaker
+	}
+	if b.m == nil {
+		b.m = make(map[statsKey]*breakerEntry)
+	}
+	state := metrics.CircuitBreakerState.WithLabelValues(fanout, endpoint.Name)
+	state.Set(float64(breaker.Closed))
+	e := &breakerEntry{
+		config: c,
+		breaker: breaker.New(breaker.Config{
+			ConsecutiveFailures: int(c.ConsecutiveFailures),
+			ErrorRate:           c.ErrorRate,
+			MinRequests:         int(c.MinRequests),
+			Window:              time.Duration(c.WindowMs) * time.Millisecond,
+			OpenDuration:        time.Duration(c.OpenMs) * time.Millisecond,
+			HalfOpenRequests:    int(c.HalfOpenRequests),
+			OnStateChange: func(_, to breaker.State) {
+				state.Set(float64(to))
+			},
+		}),
+	}
+	b.m[key] = e
+	return e.breaker
+}
+
+// doWithBreaker makes the request to the endpoint unless the
+// endpoint's circuit breaker is open, and records the outcome.
+// Errors and 5xx responses are failures.
+func (worker *Worker) doWithBreaker(r *http.Request, fanout string, endpoint *pb.Endpoint) (*workerResponse, int, error) {
+	b := worker.handler.breakers.get(fanout, endpoint)
+	if b == nil {
+		return worker.doWithLimits(r, fanout, endpoint)
+	}
+	generation, ok := b.Allow()
+	if !ok {
+		metrics.CircuitBreakerRejected.WithLabelValues(fanout, endpoint.Name).Inc()
+		return nil, 0, errCircuitOpen
+	}
+	resp, attempts, err := worker.doWithLimits(r, fanout, endpoint)
+	switch {
+	case errors.Is(err, context.Canceled) || errors.Is(err, errLimited) || errors.Is(err, errRateLimited):
+		// Cancelled by the caller, e.g. a hedged request that
+		// lost, or never sent; it says nothing about the endpoint.
+		b.Release(generation)
+	default:
+		b.Record(generation, err == nil && resp.code < http.StatusInternalServerError)
+	}
+	return resp, attempts, err
+}
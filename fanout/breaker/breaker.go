@@ -0,0 +1,255 @@
+// Package breaker implements a circuit breaker that stops sending
+// requests to an endpoint that keeps failing, and lets a few trial
+// requests through after a while to find out if it has recovered.
+package breaker
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	DefaultConsecutiveFailures = 5
+	DefaultMinRequests         = 20
+	DefaultWindow              = 10 * time.Second
+	DefaultOpenDuration        = 5 * time.Second
+	DefaultHalfOpenRequests    = 1
+)
+
+// State is the state of a breaker.
+type State int
+
+const (
+	// Closed lets all requests through.
+	Closed State = iota
+
+	// Open rejects all requests.
+	Open
+
+	// HalfOpen lets a limited number of trial requests through.
+	// The breaker closes if they all succeed, and opens again if
+	// any of them fails.
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+type Config struct {
+	// ConsecutiveFailures opens the breaker after the given number
+	// of consecutive failures. If zero, consecutive failures don't
+	// open the breaker.
+	ConsecutiveFailures int
+
+	// ErrorRate opens the breaker if the fraction of failed requests
+	// in the window exceeds it. If zero, the error rate doesn't open
+	// the breaker.
+	ErrorRate float64
+
+	// MinRequests is the minimum number of requests in the
+	// window before the error rate is considered.
+	MinRequests int
+
+	// Window is the duration the error rate is computed over.
+	Window time.Duration
+
+	// OpenDuration is how long the breaker stays open
+	// before letting trial requests through.
+	OpenDuration time.Duration
+
+	// HalfOpenRequests is the number of trial requests.
+	HalfOpenRequests int
+
+	// OnStateChange is called when the breaker changes its state.
+	// It is called with the breaker locked, it must not call the breaker.
+	OnStateChange func(from, to State)
+}
+
+// numBuckets is the number of buckets the window is divided into.
+const numBuckets = 10
+
+type bucket struct {
+	start    time.Time
+	requests int
+	failures int
+}
+
+// Breaker is a circuit breaker. It is safe for concurrent use.
+type Breaker struct {
+	config Config
+	now    func() time.Time
+
+	mu          sync.Mutex
+	state       State
+	generation  uint64 // incremented on every state change
+	consecutive int
+	buckets     [numBuckets]bucket
+	openedAt    time.Time
+	trials      int // trial requests let through while half-open
+	successes   int // successful trial requests
+}
+
+// New returns a closed breaker. Zero values in the config are set to
+// their defaults. If neither ConsecutiveFailures nor ErrorRate is set,
+// the breaker opens after DefaultConsecutiveFailures failures.
+func New(c Config) *Breaker {
+	if c.ConsecutiveFailures <= 0 && c.ErrorRate <= 0 {
+		c.ConsecutiveFailures = DefaultConsecutiveFailures
+	}
+	if c.MinRequests <= 0 {
+		c.MinRequests = DefaultMinRequests
+	}
+	if c.Window <= 0 {
+		c.Window = DefaultWindow
+	}
+	if c.OpenDuration <= 0 {
+		c.OpenDuration = DefaultOpenDuration
+	}
+	if c.HalfOpenRequests <= 0 {
+		c.HalfOpenRequests = DefaultHalfOpenRequests
+	}
+	return &Breaker{config: c, now: time.Now}
+}
+
+// State returns the current state of the breaker.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.expire(b.now())
+	return b.state
+}
+
+// Allow reports whether a request can be sent. If it returns true,
+// the outcome of the request must be reported with Record, along with
+// the returned generation.
+func (b *Breaker) Allow() (generation uint64, ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.expire(b.now())
+	switch b.state {
+	case Open:
+		return 0, false
+	case HalfOpen:
+		if b.trials >= b.config.HalfOpenRequests {
+			return 0, false
+		}
+		b.trials++
+	}
+	return b.generation, true
+}
+
+// Record records the outcome of a request allowed by Allow. Outcomes
+// of requests allowed before the breaker changed its state are ignored.
+func (b *Breaker) Record(generation uint64, success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	b.expire(now)
+	if generation != b.generation {
+		return
+	}
+
+	switch b.state {
+	case HalfOpen:
+		if !success {
+			b.setState(Open, now)
+			return
+		}
+		b.successes++
+		if b.successes >= b.config.HalfOpenRequests {
+			b.setState(Closed, now)
+		}
+	case Closed:
+		bucket := b.bucket(now)
+		bucket.requests++
+		if success {
+			b.consecutive = 0
+			return
+		}
+		bucket.failures++
+		b.consecutive++
+		if b.tripped(now) {
+			b.setState(Open, now)
+		}
+	}
+}
+
+// Release releases a request allowed by Allow without recording
+// its outcome, e.g. if the request was cancelled by the caller.
+func (b *Breaker) Release(generation uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.expire(b.now())
+	if generation == b.generation && b.state == HalfOpen && b.trials > 0 {
+		b.trials--
+	}
+}
+
+// tripped reports whether the failures should open the breaker.
+func (b *Breaker) tripped(now time.Time) bool {
+	if n := b.config.ConsecutiveFailures; n > 0 && b.consecutive >= n {
+		return true
+	}
+	if b.config.ErrorRate <= 0 {
+		return false
+	}
+	var requests, failures int
+	for _, bucket := range b.buckets {
+		if now.Sub(bucket.start) < b.config.Window {
+			requests += bucket.requests
+			failures += bucket.failures
+		}
+	}
+	return requests >= b.config.MinRequests &&
+		float64(failures)/float64(requests) > b.config.ErrorRate
+}
+
+// bucket returns the bucket of the window for the given time,
+// resetting it if it belongs to an earlier window.
+func (b *Breaker) bucket(now time.Time) *bucket {
+	width := b.config.Window / numBuckets
+	if width <= 0 {
+		width = 1
+	}
+	start := now.Truncate(width)
+	bucket := &b.buckets[(start.UnixNano()/int64(width))%numBuckets]
+	if !bucket.start.Equal(start) {
+		bucket.start, bucket.requests, bucket.failures = start, 0, 0
+	}
+	return bucket
+}
+
+// expire moves an open breaker to half-open once it has been
+// open for the open duration.
+func (b *Breaker) expire(now time.Time) {
+	if b.state == Open && now.Sub(b.openedAt) >= b.config.OpenDuration {
+		b.setState(HalfOpen, now)
+	}
+}
+
+func (b *Breaker) setState(state State, now time.Time) {
+	from := b.state
+	b.state = state
+	b.generation++
+	b.consecutive = 0
+	b.trials, b.successes = 0, 0
+	switch state {
+	case Open:
+		b.openedAt = now
+	case Closed:
+		b.buckets = [numBuckets]bucket{}
+	}
+	if b.config.OnStateChange != nil {
+		b.config.OnStateChange(from, state)
+	}
+}
@@ -0,0 +1,152 @@
+package breaker
+
+import (
+	"testing"
+	"time"
+)
+
+// op is a step of a test: advancing the clock, sending a request that
+// succeeds or fails, a request the breaker rejects, or checking the state.
+type op struct {
+	advance  time.Duration
+	send     string // "ok", "fail", "release" or "rejected"
+	times    int
+	want     State
+	checking bool
+}
+
+func advance(d time.Duration) op    { return op{advance: d} }
+func send(outcome string, n int) op { return op{send: outcome, times: n} }
+func state(s State) op              { return op{want: s, checking: true} }
+
+func TestBreaker(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		ops    []op
+	}{
+		{
+			name:   "consecutive failures",
+			config: Config{ConsecutiveFailures: 3},
+			ops: []op{
+				send("fail", 2), send("ok", 1), send("fail", 2), state(Closed),
+				send("fail", 1), state(Open), send("rejected", 1),
+			},
+		},
+		{
+			name:   "half-open after the open duration",
+			config: Config{ConsecutiveFailures: 1, OpenDuration: time.Second},
+			ops: []op{
+				send("fail", 1), advance(999 * time.Millisecond), state(Open),
+				advance(time.Millisecond), state(HalfOpen),
+			},
+		},
+		{
+			name:   "trials close the breaker",
+			config: Config{ConsecutiveFailures: 1, OpenDuration: time.Second, HalfOpenRequests: 2},
+			ops: []op{
+				send("fail", 1), advance(time.Second),
+				send("ok", 1), state(HalfOpen), send("ok", 1), state(Closed),
+				send("ok", 1),
+			},
+		},
+		{
+			name:   "a failed trial opens the breaker",
+			config: Config{ConsecutiveFailures: 1, OpenDuration: time.Second, HalfOpenRequests: 2},
+			ops: []op{
+				send("fail", 1), advance(time.Second),
+				send("ok", 1), send("fail", 1), state(Open), send("rejected", 1),
+			},
+		},
+		{
+			name:   "trials are limited",
+			config: Config{ConsecutiveFailures: 1, OpenDuration: time.Second},
+			ops: []op{
+				send("fail", 1), advance(time.Second),
+				send("release", 1), send("release", 1), state(HalfOpen),
+			},
+		},
+		{
+			name:   "error rate",
+			config: Config{ErrorRate: 0.5, MinRequests: 4, Window: 10 * time.Second},
+			ops: []op{
+				send("fail", 3), state(Closed), // under the min requests
+				send("ok", 3), state(Closed), // 3 of 6
+				send("fail", 1), state(Open), // 4 of 7
+			},
+		},
+		{
+			name:   "error rate window",
+			config: Config{ErrorRate: 0.5, MinRequests: 4, Window: 10 * time.Second},
+			ops: []op{
+				send("fail", 3), send("ok", 1),
+				advance(10 * time.Second), // the failures leave the window
+				send("fail", 2), send("ok", 2), state(Closed),
+			},
+		},
+	}
+	for _, tt := range tests {
+		b := New(tt.config)
+		now := time.Unix(1000, 0)
+		b.now = func() time.Time { return now }
+		for i, op := range tt.ops {
+			now = now.Add(op.advance)
+			if op.checking {
+				if got := b.State(); got != op.want {
+					t.Errorf("%s: op %d: State() = %v, want %v", tt.name, i, got, op.want)
+				}
+				continue
+			}
+			for n := 0; n < op.times; n++ {
+				generation, ok := b.Allow()
+				if ok != (op.send != "rejected") {
+					t.Errorf("%s: op %d: Allow() = %v", tt.name, i, ok)
+					continue
+				}
+				switch op.send {
+				case "ok", "fail":
+					b.Record(generation, op.send == "ok")
+				case "release":
+					// The trial isn't released, so the next one is rejected.
+					if _, ok := b.Allow(); ok {
+						t.Errorf("%s: op %d: Allow() = true while the trial is in flight", tt.name, i)
+					}
+					b.Release(generation)
+				}
+			}
+		}
+	}
+}
+
+func TestStaleOutcomes(t *testing.T) {
+	b := New(Config{ConsecutiveFailures: 1, OpenDuration: time.Second})
+	now := time.Unix(1000, 0)
+	b.now = func() time.Time { return now }
+	var changes []State
+	b.config.OnStateChange = func(from, to State) { changes = append(changes, to) }
+
+	stale, _ := b.Allow()
+	generation, _ := b.Allow()
+	b.Record(generation, false)
+	now = now.Add(time.Second)
+	trial, _ := b.Allow()
+	// The outcome of a request allowed while closed
+	// doesn't count as the outcome of the trial.
+	b.Record(stale, false)
+	if got := b.State(); got != HalfOpen {
+		t.Fatalf("State() = %v after a stale failure, want %v", got, HalfOpen)
+	}
+	b.Record(trial, true)
+	if got := b.State(); got != Closed {
+		t.Fatalf("State() = %v, want %v", got, Closed)
+	}
+	want := []State{Open, HalfOpen, Closed}
+	if len(changes) != len(want) {
+		t.Fatalf("state changes = %v, want %v", changes, want)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Fatalf("state changes = %v, want %v", changes, want)
+		}
+	}
+}
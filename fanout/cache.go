@@ -3,11 +3,13 @@ package fanout
 import (
 	"context"
 	"errors"
-	"log"
+	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/dfanout/dfanout/fanout/clientcache"
+	"github.com/dfanout/dfanout/logging"
 	pb "github.com/dfanout/dfanout/proto"
 	"github.com/mailgun/groupcache"
 )
@@ -17,8 +19,13 @@ type Cache struct {
 	group *groupcache.Group
 }
 
+// NewFanoutCache returns a cache of the fanouts shared by the peers.
+// me is the base URL of this peer, or its host:port if it serves HTTP.
 func NewFanoutCache(me string, peers []string, ccache *clientcache.Cache, adminService pb.AdminService, ttl time.Duration) *Cache {
-	pool := groupcache.NewHTTPPool("http://" + me)
+	if !strings.Contains(me, "://") {
+		me = "http://" + me
+	}
+	pool := groupcache.NewHTTPPool(me)
 	if len(peers) > 0 {
 		pool.Set(peers...)
 	}
@@ -27,7 +34,7 @@ func NewFanoutCache(me string, peers []string, ccache *clientcache.Cache, adminS
 		pool: pool,
 		group: groupcache.NewGroup("dfanout-fanout-cache", 128<<20, groupcache.GetterFunc(
 			func(ctx groupcache.Context, key string, dest groupcache.Sink) error {
-				log.Printf("Looking up to cache for %q", key)
+				slog.Debug("Looking up the fanout", logging.Fanout, key)
 				resp, err := adminService.GetFanout(context.Background(), &pb.GetFanoutRequest{
 					FanName: key,
 				})
@@ -38,8 +45,8 @@ func NewFanoutCache(me string, peers []string, ccache *clientcache.Cache, adminS
 					return errors.New("no endpoints found")
 				}
 				for _, e := range resp.Endpoints {
-					if _, err := ccache.RegisterHTTPClient(key, e); err != nil {
-						return nil
+					if err := ccache.Register(key, e); err != nil {
+						return err
 					}
 				}
 				return dest.SetProto(resp, time.Now().Add(ttl))
@@ -48,12 +55,23 @@ func NewFanoutCache(me string, peers []string, ccache *clientcache.Cache, adminS
 	}
 }
 
-func (c *Cache) Fanout(ctx context.Context, fanout string) ([]*pb.Endpoint, error) {
+func (c *Cache) Fanout(ctx context.Context, fanout string) (*pb.GetFanoutResponse, error) {
 	var resp pb.GetFanoutResponse
 	if err := c.group.Get(ctx, fanout, groupcache.ProtoSink(&resp)); err != nil {
 		return nil, err
 	}
-	return resp.Endpoints, nil
+	return &resp, nil
+}
+
+// SetTransport sets the transport of the requests to the peers,
+// e.g. to authenticate them.
+func (c *Cache) SetTransport(rt http.RoundTripper) {
+	c.pool.Transport = func(groupcache.Context) http.RoundTripper { return rt }
+}
+
+// Stats returns the stats of the underlying groupcache group.
+func (c *Cache) Stats() *groupcache.Stats {
+	return &c.group.Stats
 }
 
 func (c *Cache) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -1,6 +1,7 @@
 package clientcache
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
@@ -9,17 +10,64 @@ import (
 	"time"
 
 	pb "github.com/dfanout/dfanout/proto"
+	"github.com/dfanout/dfanout/secrets"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
 )
 
 const defaultTimeout = 15 * time.Second
 
+// resolveTimeout bounds resolving the secrets of a client.
+const resolveTimeout = 5 * time.Second
+
 type Cache struct {
 	sync.RWMutex
 	httpClients map[string]*http.Client
+	grpcConns   map[string]*grpcConn
+	refs        map[string]string // key -> secret reference of the TLS key
+
+	// Secrets resolves the private keys referenced by TLS configs.
+	Secrets *secrets.Store
+}
+
+type grpcConn struct {
+	conn     *grpc.ClientConn
+	endpoint *pb.GRPCEndpoint
 }
 
 func New() *Cache {
-	return &Cache{httpClients: make(map[string]*http.Client)}
+	return &Cache{
+		httpClients: make(map[string]*http.Client),
+		grpcConns:   make(map[string]*grpcConn),
+		refs:        make(map[string]string),
+	}
+}
+
+// Timeout returns the timeout for the given timeout in milliseconds,
+// or the default timeout if it is not set.
+func Timeout(ms int64) time.Duration {
+	if ms > 0 {
+		return time.Duration(ms) * time.Millisecond
+	}
+	return defaultTimeout
+}
+
+// Register creates and caches the client for the endpoint's destination.
+func (c *Cache) Register(fanout string, e *pb.Endpoint) error {
+	var err error
+	switch e.Destination.(type) {
+	case *pb.Endpoint_HttpEndpoint, *pb.Endpoint_TwirpEndpoint:
+		_, err = c.RegisterHTTPClient(fanout, e)
+	case *pb.Endpoint_GrpcEndpoint:
+		_, err = c.RegisterGRPCConn(fanout, e)
+	case *pb.Endpoint_FanoutEndpoint:
+		// Fanout endpoints are served in process.
+	default:
+		err = fmt.Errorf("unsupported destination for %q, %q", fanout, e.Name)
+	}
+	return err
 }
 
 func (c *Cache) HTTPClient(fanout string, e *pb.Endpoint) (*http.Client, error) {
@@ -44,46 +92,145 @@ func (c *Cache) key(fanout string, endpointName string) string {
 	return fanout + ":" + endpointName // TODO: Make ":" a reserved character
 }
 
+// RegisterHTTPClient creates a client for HTTP and Twirp endpoints.
 func (c *Cache) RegisterHTTPClient(fanout string, e *pb.Endpoint) (*http.Client, error) {
-	httpEndpoint := e.Destination.(*pb.Endpoint_HttpEndpoint).HttpEndpoint
+	var (
+		tlsConfig *pb.TLSConfig
+		timeoutMs int64
+	)
+	switch d := e.Destination.(type) {
+	case *pb.Endpoint_HttpEndpoint:
+		tlsConfig, timeoutMs = d.HttpEndpoint.TlsConfig, d.HttpEndpoint.TimeoutMs
+	case *pb.Endpoint_TwirpEndpoint:
+		tlsConfig, timeoutMs = d.TwirpEndpoint.TlsConfig, d.TwirpEndpoint.TimeoutMs
+	default:
+		return nil, fmt.Errorf("%q, %q is not an HTTP endpoint", fanout, e.Name)
+	}
 
 	tr := &http.Transport{}
-	if tlsConfig := httpEndpoint.TlsConfig; tlsConfig != nil {
-		config := &tls.Config{}
-		config.ServerName = tlsConfig.ServerName
-		config.InsecureSkipVerify = tlsConfig.InsecureSkipVerify
-		if tlsConfig.CaPem != nil {
-			roots := x509.NewCertPool()
-			ok := roots.AppendCertsFromPEM(tlsConfig.CaPem)
-			if !ok {
-				return nil, fmt.Errorf("failed to parse root certificate for %q, %q", fanout, e.Name)
-			}
-			config.RootCAs = roots
-			config.ClientAuth = tls.RequireAndVerifyClientCert
-		}
-		if tlsConfig.CaPem != nil && tlsConfig.KeyPem != nil {
-			cert, err := tls.X509KeyPair(tlsConfig.CaPem, tlsConfig.KeyPem)
-			if err != nil {
-				return nil, fmt.Errorf("failed to load X509 key value pair for %q, %q: %w", fanout, e.Name, err)
-			}
-			config.Certificates = []tls.Certificate{cert}
+	if tlsConfig != nil {
+		config, err := c.newTLSConfig(fanout, e.Name, tlsConfig)
+		if err != nil {
+			return nil, err
 		}
 		tr.TLSClientConfig = config
 	}
 
-	timeout := defaultTimeout
-	if tms := httpEndpoint.TimeoutMs; tms > 0 {
-		timeout = time.Duration(tms) * time.Millisecond
+	client := &http.Client{
+		Transport: tr,
+		Timeout:   Timeout(timeoutMs),
 	}
 
 	c.Lock()
 	defer c.Unlock()
 
-	client := &http.Client{Transport: tr}
-	c.httpClients[c.key(fanout, e.Name)] = client
+	key := c.key(fanout, e.Name)
+	c.httpClients[key] = client
+	c.refs[key] = tlsConfig.GetKeyPemRef()
+	return client, nil
+}
 
-	return &http.Client{
-		Transport: tr,
-		Timeout:   timeout,
-	}, nil
+func (c *Cache) GRPCConn(fanout string, e *pb.Endpoint) (*grpc.ClientConn, error) {
+	key := c.key(fanout, e.Name)
+
+	c.RLock()
+	cc, ok := c.grpcConns[key]
+	c.RUnlock()
+
+	if ok {
+		return cc.conn, nil
+	}
+	return c.RegisterGRPCConn(fanout, e)
+}
+
+// RegisterGRPCConn dials the endpoint's target. Connections are reused
+// if the endpoint's config hasn't changed since the last registration.
+func (c *Cache) RegisterGRPCConn(fanout string, e *pb.Endpoint) (*grpc.ClientConn, error) {
+	grpcEndpoint := e.Destination.(*pb.Endpoint_GrpcEndpoint).GrpcEndpoint
+	key := c.key(fanout, e.Name)
+
+	c.RLock()
+	cc, ok := c.grpcConns[key]
+	c.RUnlock()
+	if ok && proto.Equal(cc.endpoint, grpcEndpoint) {
+		return cc.conn, nil
+	}
+
+	creds := insecure.NewCredentials()
+	if tlsConfig := grpcEndpoint.TlsConfig; tlsConfig != nil {
+		config, err := c.newTLSConfig(fanout, e.Name, tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(config)
+	}
+
+	// Dialing is non-blocking, connections are established lazily.
+	conn, err := grpc.Dial(grpcEndpoint.Target, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %q for %q, %q: %w", grpcEndpoint.Target, fanout, e.Name, err)
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if old, ok := c.grpcConns[key]; ok {
+		// In-flight calls fail when the old connection is closed,
+		// give them the default timeout to finish.
+		time.AfterFunc(defaultTimeout, func() { old.conn.Close() })
+	}
+	c.grpcConns[key] = &grpcConn{conn: conn, endpoint: grpcEndpoint}
+	c.refs[key] = grpcEndpoint.TlsConfig.GetKeyPemRef()
+	return conn, nil
+}
+
+// InvalidateSecret drops the clients that use the referenced secret,
+// so they are created again with its new value.
+func (c *Cache) InvalidateSecret(ref string) {
+	c.Lock()
+	defer c.Unlock()
+	for key, r := range c.refs {
+		if r != ref {
+			continue
+		}
+		delete(c.httpClients, key)
+		if old, ok := c.grpcConns[key]; ok {
+			time.AfterFunc(defaultTimeout, func() { old.conn.Close() })
+			delete(c.grpcConns, key)
+		}
+		delete(c.refs, key)
+	}
+}
+
+func (c *Cache) newTLSConfig(fanout, endpoint string, tlsConfig *pb.TLSConfig) (*tls.Config, error) {
+	config := &tls.Config{}
+	config.ServerName = tlsConfig.ServerName
+	config.InsecureSkipVerify = tlsConfig.InsecureSkipVerify
+	if tlsConfig.CaPem != nil {
+		roots := x509.NewCertPool()
+		ok := roots.AppendCertsFromPEM(tlsConfig.CaPem)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse root certificate for %q, %q", fanout, endpoint)
+		}
+		config.RootCAs = roots
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	keyPem := tlsConfig.KeyPem
+	if ref := tlsConfig.KeyPemRef; ref != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), resolveTimeout)
+		defer cancel()
+		v, err := c.Secrets.Resolve(ctx, ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve the private key for %q, %q: %w", fanout, endpoint, err)
+		}
+		keyPem = v
+	}
+	if tlsConfig.CertPem != nil && keyPem != nil {
+		cert, err := tls.X509KeyPair(tlsConfig.CertPem, keyPem)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load X509 key value pair for %q, %q: %w", fanout, endpoint, err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
 }
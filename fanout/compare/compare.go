@@ -0,0 +1,250 @@
+// Package compare compares responses of secondary endpoints
+// to the primary endpoint's response.
+package compare
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// missing is reported as the value of fields that
+// only exist in one of the responses.
+const missing = "(missing)"
+
+// Response is a captured endpoint response.
+type Response struct {
+	Status int
+	Header http.Header
+	Body   []byte
+
+	// Truncated is set if the body was larger than
+	// the capture limit. Truncated bodies are not compared.
+	Truncated bool
+}
+
+// Difference is a difference between the primary
+// and a secondary response.
+type Difference struct {
+	// Path is "status", "header.<Name>", "body" or
+	// "body.<JSON path>" for JSON bodies, e.g. "body.items.0.id".
+	Path      string
+	Primary   string
+	Secondary string
+}
+
+type Options struct {
+	// IgnorePaths are the JSON body paths to ignore. Paths are dot
+	// separated field names or array indexes, "*" matches any
+	// field or index, e.g. "meta.request_id" or "items.*.updated_at".
+	IgnorePaths []string
+
+	// Headers are the headers to compare.
+	Headers []string
+
+	// NumericTolerance is the maximum absolute difference
+	// between JSON numbers to consider them equal.
+	NumericTolerance float64
+}
+
+// Responses returns the differences between the primary and
+// secondary responses. It returns nil if they match.
+func Responses(primary, secondary *Response, opts Options) []Difference {
+	var diffs []Difference
+	if primary.Status != secondary.Status {
+		diffs = append(diffs, Difference{
+			Path:      "status",
+			Primary:   strconv.Itoa(primary.Status),
+			Secondary: strconv.Itoa(secondary.Status),
+		})
+	}
+	for _, h := range opts.Headers {
+		p := strings.Join(primary.Header.Values(h), ", ")
+		s := strings.Join(secondary.Header.Values(h), ", ")
+		if p != s {
+			diffs = append(diffs, Difference{
+				Path:      "header." + http.CanonicalHeaderKey(h),
+				Primary:   p,
+				Secondary: s,
+			})
+		}
+	}
+	if primary.Truncated || secondary.Truncated {
+		return diffs
+	}
+
+	pv, perr := decodeJSON(primary.Body)
+	sv, serr := decodeJSON(secondary.Body)
+	if perr != nil || serr != nil {
+		// Not JSON, compare the bytes.
+		if !bytes.Equal(primary.Body, secondary.Body) {
+			diffs = append(diffs, Difference{
+				Path:      "body",
+				Primary:   fmt.Sprintf("%d bytes", len(primary.Body)),
+				Secondary: fmt.Sprintf("%d bytes", len(secondary.Body)),
+			})
+		}
+		return diffs
+	}
+
+	d := &differ{opts: opts, ignore: splitPaths(opts.IgnorePaths)}
+	d.diff(nil, pv, sv)
+	return append(diffs, d.diffs...)
+}
+
+func decodeJSON(b []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("trailing data after JSON value")
+	}
+	return v, nil
+}
+
+type differ struct {
+	opts   Options
+	ignore [][]string
+	diffs  []Difference
+}
+
+func (d *differ) diff(path []string, p, s any) {
+	if d.ignored(path) {
+		return
+	}
+	switch pv := p.(type) {
+	case map[string]any:
+		sv, ok := s.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(pv)+len(sv))
+		for k := range pv {
+			keys = append(keys, k)
+		}
+		for k := range sv {
+			if _, ok := pv[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			d.diffField(append(path, k), pv, sv, k)
+		}
+		return
+	case []any:
+		sv, ok := s.([]any)
+		if !ok {
+			break
+		}
+		n := len(pv)
+		if len(sv) > n {
+			n = len(sv)
+		}
+		for i := 0; i < n; i++ {
+			elem := append(path, strconv.Itoa(i))
+			switch {
+			case i >= len(pv):
+				d.report(elem, missing, encode(sv[i]))
+			case i >= len(sv):
+				d.report(elem, encode(pv[i]), missing)
+			default:
+				d.diff(elem, pv[i], sv[i])
+			}
+		}
+		return
+	case json.Number:
+		sv, ok := s.(json.Number)
+		if !ok {
+			break
+		}
+		if pv == sv {
+			return
+		}
+		pf, perr := pv.Float64()
+		sf, serr := sv.Float64()
+		if perr == nil && serr == nil && math.Abs(pf-sf) <= d.opts.NumericTolerance {
+			return
+		}
+		d.report(path, pv.String(), sv.String())
+		return
+	default:
+		if p == s {
+			return
+		}
+	}
+	d.report(path, encode(p), encode(s))
+}
+
+func (d *differ) diffField(path []string, p, s map[string]any, key string) {
+	pv, pok := p[key]
+	sv, sok := s[key]
+	switch {
+	case !pok:
+		if !d.ignored(path) {
+			d.report(path, missing, encode(sv))
+		}
+	case !sok:
+		if !d.ignored(path) {
+			d.report(path, encode(pv), missing)
+		}
+	default:
+		d.diff(path, pv, sv)
+	}
+}
+
+func (d *differ) report(path []string, p, s string) {
+	d.diffs = append(d.diffs, Difference{
+		Path:      strings.Join(append([]string{"body"}, path...), "."),
+		Primary:   p,
+		Secondary: s,
+	})
+}
+
+func (d *differ) ignored(path []string) bool {
+	for _, pattern := range d.ignore {
+		if matchPath(pattern, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPath reports whether the path is under the pattern.
+func matchPath(pattern, path []string) bool {
+	if len(path) < len(pattern) {
+		return false
+	}
+	for i, p := range pattern {
+		if p != "*" && p != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func splitPaths(paths []string) [][]string {
+	split := make([][]string, 0, len(paths))
+	for _, p := range paths {
+		if p = strings.TrimPrefix(p, "body."); p != "" {
+			split = append(split, strings.Split(p, "."))
+		}
+	}
+	return split
+}
+
+func encode(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
@@ -0,0 +1,106 @@
+package compare
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestResponses(t *testing.T) {
+	tests := []struct {
+		name      string
+		primary   *Response
+		secondary *Response
+		opts      Options
+		want      []Difference
+	}{
+		{
+			name:      "equal",
+			primary:   &Response{Status: 200, Body: []byte(`{"a":1,"b":[1,2]}`)},
+			secondary: &Response{Status: 200, Body: []byte(`{"b":[1,2],"a":1}`)},
+		},
+		{
+			name:      "status",
+			primary:   &Response{Status: 200},
+			secondary: &Response{Status: 500},
+			want:      []Difference{{Path: "status", Primary: "200", Secondary: "500"}},
+		},
+		{
+			name:      "headers",
+			primary:   &Response{Status: 200, Header: http.Header{"Content-Type": {"application/json"}, "X-Other": {"a"}}},
+			secondary: &Response{Status: 200, Header: http.Header{"Content-Type": {"text/plain"}, "X-Other": {"b"}}},
+			opts:      Options{Headers: []string{"content-type"}},
+			want:      []Difference{{Path: "header.Content-Type", Primary: "application/json", Secondary: "text/plain"}},
+		},
+		{
+			name:      "not JSON",
+			primary:   &Response{Status: 200, Body: []byte("ok")},
+			secondary: &Response{Status: 200, Body: []byte("okay")},
+			want:      []Difference{{Path: "body", Primary: "2 bytes", Secondary: "4 bytes"}},
+		},
+		{
+			name:      "truncated",
+			primary:   &Response{Status: 200, Body: []byte(`{"a":1}`), Truncated: true},
+			secondary: &Response{Status: 200, Body: []byte(`{"a":2}`)},
+		},
+		{
+			name:      "fields",
+			primary:   &Response{Status: 200, Body: []byte(`{"a":1,"b":"x","c":{"d":true}}`)},
+			secondary: &Response{Status: 200, Body: []byte(`{"a":1,"b":"y","e":null}`)},
+			want: []Difference{
+				{Path: "body.b", Primary: `"x"`, Secondary: `"y"`},
+				{Path: "body.c", Primary: `{"d":true}`, Secondary: missing},
+				{Path: "body.e", Primary: missing, Secondary: "null"},
+			},
+		},
+		{
+			name:      "arrays",
+			primary:   &Response{Status: 200, Body: []byte(`{"items":[{"id":1},{"id":2}]}`)},
+			secondary: &Response{Status: 200, Body: []byte(`{"items":[{"id":3}]}`)},
+			want: []Difference{
+				{Path: "body.items.0.id", Primary: "1", Secondary: "3"},
+				{Path: "body.items.1", Primary: `{"id":2}`, Secondary: missing},
+			},
+		},
+		{
+			name:      "types",
+			primary:   &Response{Status: 200, Body: []byte(`{"a":1,"b":[1]}`)},
+			secondary: &Response{Status: 200, Body: []byte(`{"a":"1","b":{"0":1}}`)},
+			want: []Difference{
+				{Path: "body.a", Primary: "1", Secondary: `"1"`},
+				{Path: "body.b", Primary: "[1]", Secondary: `{"0":1}`},
+			},
+		},
+		{
+			name:      "numbers in another form",
+			primary:   &Response{Status: 200, Body: []byte(`{"a":1,"b":100}`)},
+			secondary: &Response{Status: 200, Body: []byte(`{"a":1.0,"b":1e2}`)},
+		},
+		{
+			name:      "numbers within the tolerance",
+			primary:   &Response{Status: 200, Body: []byte(`{"a":1.0,"b":2.0}`)},
+			secondary: &Response{Status: 200, Body: []byte(`{"a":1.05,"b":2.5}`)},
+			opts:      Options{NumericTolerance: 0.1},
+			want:      []Difference{{Path: "body.b", Primary: "2.0", Secondary: "2.5"}},
+		},
+		{
+			name:      "ignored paths",
+			primary:   &Response{Status: 200, Body: []byte(`{"meta":{"request_id":"a","v":1},"items":[{"id":1,"updated_at":"t1"}]}`)},
+			secondary: &Response{Status: 200, Body: []byte(`{"meta":{"request_id":"b","v":2},"items":[{"id":1,"updated_at":"t2"}]}`)},
+			opts:      Options{IgnorePaths: []string{"meta.request_id", "body.items.*.updated_at"}},
+			want:      []Difference{{Path: "body.meta.v", Primary: "1", Secondary: "2"}},
+		},
+		{
+			name:      "ignored missing field",
+			primary:   &Response{Status: 200, Body: []byte(`{"a":1,"trace":"x"}`)},
+			secondary: &Response{Status: 200, Body: []byte(`{"a":1}`)},
+			opts:      Options{IgnorePaths: []string{"trace"}},
+		},
+	}
+	for _, tt := range tests {
+		got := Responses(tt.primary, tt.secondary, tt.opts)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Responses() = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
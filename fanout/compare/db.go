@@ -0,0 +1,168 @@
+package compare
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/dfanout/dfanout/logging"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DefaultFlushInterval is the default interval of
+// adding the results of a node to the DB.
+const DefaultFlushInterval = 10 * time.Second
+
+// DB keeps the comparison results of the cluster in Postgres.
+// The nodes record their comparisons in their stores, and add
+// them to the DB periodically.
+type DB struct {
+	pool       *pgxpool.Pool
+	maxSamples int
+}
+
+// NewDB returns a DB that keeps up to maxSamples
+// mismatches for each endpoint.
+func NewDB(pool *pgxpool.Pool, maxSamples int) *DB {
+	if maxSamples <= 0 {
+		maxSamples = DefaultMaxSamples
+	}
+	return &DB{pool: pool, maxSamples: maxSamples}
+}
+
+// Run adds the results recorded in the store to the
+// DB every interval, until the context is done.
+func (db *DB) Run(ctx context.Context, s *Store, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultFlushInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := db.flush(ctx, s); err != nil && ctx.Err() == nil {
+				slog.Error("Cannot add the comparison results to the database", logging.Error, err)
+			}
+		}
+	}
+}
+
+// flush adds the results recorded in the store since the last flush.
+// If they can't be added, they are kept for the next flush.
+func (db *DB) flush(ctx context.Context, s *Store) (err error) {
+	taken := s.take()
+	if len(taken) == 0 {
+		return nil
+	}
+	defer func() {
+		if err != nil {
+			s.restore(taken)
+		}
+	}()
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for _, r := range taken {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO mismatches (fanout_name, endpoint_name, compared, mismatched)
+			 VALUES ($1, $2, $3, $4)
+			 ON CONFLICT (fanout_name, endpoint_name) DO UPDATE
+			 SET compared = mismatches.compared + EXCLUDED.compared,
+			     mismatched = mismatches.mismatched + EXCLUDED.mismatched`,
+			r.fanout, r.endpoint, r.compared, r.mismatched)
+		if err != nil {
+			return err
+		}
+		if len(r.samples) == 0 {
+			continue
+		}
+		for _, m := range r.samples {
+			diffs, err := json.Marshal(m.Differences)
+			if err != nil {
+				return err
+			}
+			_, err = tx.Exec(ctx,
+				`INSERT INTO mismatch_samples (fanout_name, endpoint_name, differences, created_at)
+				 VALUES ($1, $2, $3, $4)`,
+				r.fanout, r.endpoint, string(diffs), m.Time.UTC())
+			if err != nil {
+				return err
+			}
+		}
+		_, err = tx.Exec(ctx,
+			`DELETE FROM mismatch_samples
+			 WHERE fanout_name = $1 AND endpoint_name = $2 AND id NOT IN (
+			     SELECT id FROM mismatch_samples
+			     WHERE fanout_name = $1 AND endpoint_name = $2
+			     ORDER BY created_at DESC, id DESC
+			     LIMIT $3)`,
+			r.fanout, r.endpoint, db.maxSamples)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// Report returns the comparison results of the fanout's endpoints added
+// to the DB by all the nodes. If endpoint is not empty, only the
+// endpoint's results are returned.
+func (db *DB) Report(ctx context.Context, fanout, endpoint string) ([]EndpointReport, error) {
+	rows, err := db.pool.Query(ctx,
+		`SELECT endpoint_name, compared, mismatched FROM mismatches
+		 WHERE fanout_name = $1 AND ($2 = '' OR endpoint_name = $2)
+		 ORDER BY endpoint_name`,
+		fanout, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	reports, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (EndpointReport, error) {
+		var r EndpointReport
+		err := row.Scan(&r.Endpoint, &r.Compared, &r.Mismatched)
+		return r, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(reports))
+	for i, r := range reports {
+		index[r.Endpoint] = i
+	}
+
+	rows, err = db.pool.Query(ctx,
+		`SELECT endpoint_name, differences, created_at FROM mismatch_samples
+		 WHERE fanout_name = $1 AND ($2 = '' OR endpoint_name = $2)
+		 ORDER BY created_at DESC, id DESC`,
+		fanout, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			name  string
+			diffs []byte
+			m     Mismatch
+		)
+		if err := rows.Scan(&name, &diffs, &m.Time); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(diffs, &m.Differences); err != nil {
+			return nil, err
+		}
+		if i, ok := index[name]; ok {
+			reports[i].Samples = append(reports[i].Samples, m)
+		}
+	}
+	return reports, rows.Err()
+}
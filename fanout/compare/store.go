@@ -0,0 +1,176 @@
+package compare
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultMaxSamples is the default number of mismatches
+// sampled for each endpoint.
+const DefaultMaxSamples = 20
+
+// Mismatch is a sampled mismatch between the primary
+// and a secondary response.
+type Mismatch struct {
+	Time        time.Time
+	Differences []Difference
+}
+
+// EndpointReport contains the comparison results of an endpoint.
+type EndpointReport struct {
+	Endpoint   string
+	Compared   int64
+	Mismatched int64
+
+	// Samples are the most recent sampled mismatches, newest first.
+	Samples []Mismatch
+}
+
+// Store keeps the comparison results of the endpoints compared
+// by this node in memory, until they are added to a DB shared
+// by the cluster.
+type Store struct {
+	maxSamples int
+
+	mu        sync.Mutex
+	endpoints map[string]map[string]*endpointResults // fanout -> endpoint -> results
+}
+
+type endpointResults struct {
+	compared   int64
+	mismatched int64
+	samples    []Mismatch // ring buffer
+	next       int
+
+	// unflushed are the results not added to the DB yet.
+	unflushed results
+}
+
+// results are the results of an endpoint recorded
+// since they were last added to the DB.
+type results struct {
+	fanout     string
+	endpoint   string
+	compared   int64
+	mismatched int64
+	samples    []Mismatch // oldest first
+}
+
+// NewStore creates a store that keeps up to maxSamples
+// mismatches for each endpoint.
+func NewStore(maxSamples int) *Store {
+	if maxSamples <= 0 {
+		maxSamples = DefaultMaxSamples
+	}
+	return &Store{
+		maxSamples: maxSamples,
+		endpoints:  make(map[string]map[string]*endpointResults),
+	}
+}
+
+// Record records a comparison. If sample is set and there are
+// differences, the differences are kept as a sample.
+func (s *Store) Record(fanout, endpoint string, diffs []Difference, sample bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	endpoints, ok := s.endpoints[fanout]
+	if !ok {
+		endpoints = make(map[string]*endpointResults)
+		s.endpoints[fanout] = endpoints
+	}
+	r, ok := endpoints[endpoint]
+	if !ok {
+		r = &endpointResults{unflushed: results{fanout: fanout, endpoint: endpoint}}
+		endpoints[endpoint] = r
+	}
+
+	r.compared++
+	r.unflushed.compared++
+	if len(diffs) == 0 {
+		return
+	}
+	r.mismatched++
+	r.unflushed.mismatched++
+	if !sample {
+		return
+	}
+	m := Mismatch{Time: time.Now(), Differences: diffs}
+	r.unflushed.samples = s.newest(append(r.unflushed.samples, m))
+	if len(r.samples) < s.maxSamples {
+		r.samples = append(r.samples, m)
+		return
+	}
+	r.samples[r.next] = m
+	r.next = (r.next + 1) % s.maxSamples
+}
+
+// Report returns the comparison results of the fanout's endpoints.
+// If endpoint is not empty, only the endpoint's results are returned.
+func (s *Store) Report(fanout, endpoint string) []EndpointReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var reports []EndpointReport
+	for name, r := range s.endpoints[fanout] {
+		if endpoint != "" && name != endpoint {
+			continue
+		}
+		samples := make([]Mismatch, len(r.samples))
+		copy(samples, r.samples)
+		sort.Slice(samples, func(i, j int) bool {
+			return samples[i].Time.After(samples[j].Time)
+		})
+		reports = append(reports, EndpointReport{
+			Endpoint:   name,
+			Compared:   r.compared,
+			Mismatched: r.mismatched,
+			Samples:    samples,
+		})
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Endpoint < reports[j].Endpoint
+	})
+	return reports
+}
+
+// newest returns the newest samples the store keeps.
+func (s *Store) newest(samples []Mismatch) []Mismatch {
+	if n := len(samples) - s.maxSamples; n > 0 {
+		return samples[n:]
+	}
+	return samples
+}
+
+// take returns the results not added to the DB yet, and resets them.
+func (s *Store) take() []results {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var taken []results
+	for _, endpoints := range s.endpoints {
+		for _, r := range endpoints {
+			if r.unflushed.compared == 0 {
+				continue
+			}
+			taken = append(taken, r.unflushed)
+			r.unflushed = results{fanout: r.unflushed.fanout, endpoint: r.unflushed.endpoint}
+		}
+	}
+	return taken
+}
+
+// restore keeps the taken results that couldn't be added
+// to the DB, to be added with the next results.
+func (s *Store) restore(taken []results) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range taken {
+		r := s.endpoints[t.fanout][t.endpoint]
+		r.unflushed.compared += t.compared
+		r.unflushed.mismatched += t.mismatched
+		r.unflushed.samples = s.newest(append(t.samples, r.unflushed.samples...))
+	}
+}
@@ -0,0 +1,67 @@
+package compare
+
+import "testing"
+
+func TestStore(t *testing.T) {
+	s := NewStore(2)
+	diff := func(path string) []Difference {
+		return []Difference{{Path: path}}
+	}
+	s.Record("f", "a", nil, true)
+	s.Record("f", "a", diff("1"), true)
+	s.Record("f", "a", diff("2"), false)
+	s.Record("f", "a", diff("3"), true)
+	s.Record("f", "a", diff("4"), true)
+	s.Record("f", "b", nil, true)
+	s.Record("g", "a", diff("g"), true)
+
+	reports := s.Report("f", "")
+	if len(reports) != 2 || reports[0].Endpoint != "a" || reports[1].Endpoint != "b" {
+		t.Fatalf("Report() = %+v, want the reports of a and b", reports)
+	}
+	a := reports[0]
+	if a.Compared != 5 || a.Mismatched != 4 {
+		t.Errorf("a compared %d and mismatched %d, want 5 and 4", a.Compared, a.Mismatched)
+	}
+	if len(a.Samples) != 2 || a.Samples[0].Differences[0].Path != "4" || a.Samples[1].Differences[0].Path != "3" {
+		t.Errorf("a samples = %+v, want the newest sampled mismatches", a.Samples)
+	}
+	if reports := s.Report("f", "b"); len(reports) != 1 || reports[0].Compared != 1 {
+		t.Errorf(`Report("f", "b") = %+v`, reports)
+	}
+}
+
+func TestStoreTake(t *testing.T) {
+	s := NewStore(2)
+	s.Record("f", "a", nil, true)
+	s.Record("f", "a", []Difference{{Path: "1"}}, true)
+	s.Record("f", "a", []Difference{{Path: "2"}}, true)
+	s.Record("f", "a", []Difference{{Path: "3"}}, true)
+
+	taken := s.take()
+	if len(taken) != 1 {
+		t.Fatalf("take() = %+v, want the results of a", taken)
+	}
+	if r := taken[0]; r.compared != 4 || r.mismatched != 3 || len(r.samples) != 2 || r.samples[1].Differences[0].Path != "3" {
+		t.Errorf("take() = %+v, want 4 compared, 3 mismatched and the newest 2 samples", r)
+	}
+	if again := s.take(); len(again) != 0 {
+		t.Errorf("take() again = %+v, want none", again)
+	}
+
+	// Results that can't be added to the DB are added with the next.
+	s.Record("f", "a", []Difference{{Path: "4"}}, true)
+	s.restore(taken)
+	taken = s.take()
+	if len(taken) != 1 {
+		t.Fatalf("take() after restore = %+v, want the results of a", taken)
+	}
+	if r := taken[0]; r.compared != 5 || r.mismatched != 4 || len(r.samples) != 2 || r.samples[1].Differences[0].Path != "4" {
+		t.Errorf("take() after restore = %+v, want 5 compared, 4 mismatched and the newest 2 samples", r)
+	}
+
+	// Taking the results doesn't reset this node's report.
+	if reports := s.Report("f", "a"); reports[0].Compared != 5 {
+		t.Errorf("Report() compared %d, want 5", reports[0].Compared)
+	}
+}
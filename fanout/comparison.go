@@ -0,0 +1,95 @@
+package fanout
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math/rand"
+
+	"github.com/dfanout/dfanout/fanout/compare"
+	"github.com/dfanout/dfanout/logging"
+)
+
+const defaultMaxCompareBytes = 1 << 20 // 1 MB
+
+// comparing reports whether responses of non-primary endpoints
+// are compared to the primary response.
+func (worker *Worker) comparing() bool {
+	return worker.config.GetComparison().GetEnabled() && worker.handler.Mismatches != nil
+}
+
+// capture captures the response for comparison. The response body
+// is replaced with a reader that replays the captured bytes, so the
+// response can still be served.
+func (worker *Worker) capture(resp *workerResponse) *compare.Response {
+	captured := &compare.Response{
+		Status: resp.code,
+		Header: resp.header,
+	}
+	if resp.twerr != nil {
+		captured.Body, _ = json.Marshal(map[string]any{
+			"code": resp.twerr.Code(),
+			"msg":  resp.twerr.Msg(),
+		})
+		return captured
+	}
+	if resp.body == nil {
+		return captured
+	}
+
+	max := int64(defaultMaxCompareBytes)
+	if v := worker.config.GetComparison().GetMaxBodyBytes(); v > 0 {
+		max = v
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.body, max+1))
+	if err != nil || int64(len(body)) > max {
+		captured.Truncated = true
+	} else {
+		captured.Body = body
+	}
+	resp.body = &readCloser{
+		Reader: io.MultiReader(bytes.NewReader(body), resp.body),
+		Closer: resp.body,
+	}
+	return captured
+}
+
+// compare compares the captured responses of non-primary
+// endpoints to the primary response and records the results.
+func (worker *Worker) compare(results []endpointResult) {
+	var primary *compare.Response
+	for i, e := range worker.endpoints {
+		if e.Primary {
+			primary = results[i].captured
+		}
+	}
+	if primary == nil {
+		return
+	}
+
+	config := worker.config.GetComparison()
+	opts := compare.Options{
+		IgnorePaths:      config.IgnorePaths,
+		Headers:          config.Headers,
+		NumericTolerance: config.NumericTolerance,
+	}
+	for i, e := range worker.endpoints {
+		if e.Primary || results[i].captured == nil {
+			continue
+		}
+		diffs := compare.Responses(primary, results[i].captured, opts)
+		sample := config.SampleRate <= 0 || rand.Float64() < config.SampleRate
+		worker.handler.Mismatches.Record(worker.fanout, e.Name, diffs, sample)
+		if len(diffs) > 0 {
+			worker.logger.Info("Response doesn't match the primary response",
+				logging.Endpoint, e.Name,
+				"differences", len(diffs),
+			)
+		}
+	}
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,128 @@
+package fanout
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/dfanout/dfanout/fanout/compare"
+	pb "github.com/dfanout/dfanout/proto"
+)
+
+// failedEndpointsHeader lists the endpoints that failed the fan.
+const failedEndpointsHeader = "DFanout-Failed-Endpoints"
+
+// endpointResult is the outcome of a request to an endpoint.
+type endpointResult struct {
+	code int
+	err  error
+
+	// skipped is set if the request wasn't sent
+	// to the endpoint, e.g. it didn't match the
+	// endpoint's match rules or was sampled out.
+	skipped bool
+
+	// captured is the captured response if responses are compared.
+	captured *compare.Response
+
+	// body is the response body if the response is aggregated,
+	// or bodyErr is set if the body can't be aggregated.
+	body    []byte
+	bodyErr error
+}
+
+// failed returns the reason of the failure, or an empty
+// string if the request succeeded.
+func (r endpointResult) failed() string {
+	switch {
+	case r.err != nil:
+		return r.err.Error()
+	case r.code >= http.StatusBadRequest:
+		return fmt.Sprintf("status %d", r.code)
+	default:
+		return ""
+	}
+}
+
+type endpointFailure struct {
+	Endpoint string `json:"endpoint"`
+	Reason   string `json:"reason"`
+}
+
+// failures returns the endpoint failures that fail the fan
+// according to the endpoints' failure modes.
+func (worker *Worker) failures(results []endpointResult) []endpointFailure {
+	var (
+		failures        []endpointFailure
+		quorumFailures  []endpointFailure
+		quorumEndpoints int
+	)
+	for i, e := range worker.endpoints {
+		if results[i].skipped {
+			continue
+		}
+		reason := results[i].failed()
+		if e.FailureMode == pb.FailureMode_FAILURE_MODE_QUORUM {
+			quorumEndpoints++
+			if reason != "" {
+				quorumFailures = append(quorumFailures, endpointFailure{Endpoint: e.Name, Reason: reason})
+			}
+		}
+		switch {
+		case e.Primary && results[i].err != nil:
+			// There is no primary response to serve. Otherwise,
+			// primary responses are served as they are.
+			failures = append(failures, endpointFailure{Endpoint: e.Name, Reason: reason})
+		case !e.Primary && e.FailureMode == pb.FailureMode_FAILURE_MODE_REQUIRED && reason != "":
+			failures = append(failures, endpointFailure{Endpoint: e.Name, Reason: reason})
+		}
+	}
+
+	if quorumEndpoints > 0 {
+		quorum := int(worker.config.GetQuorum())
+		if quorum <= 0 {
+			quorum = quorumEndpoints/2 + 1
+		}
+		if quorum > quorumEndpoints {
+			// Some of the endpoints were skipped,
+			// all the others need to succeed.
+			quorum = quorumEndpoints
+		}
+		if quorumEndpoints-len(quorumFailures) < quorum {
+			for _, f := range quorumFailures {
+				if !containsFailure(failures, f.Endpoint) {
+					failures = append(failures, f)
+				}
+			}
+		}
+	}
+	return failures
+}
+
+func containsFailure(failures []endpointFailure, endpoint string) bool {
+	for _, f := range failures {
+		if f.Endpoint == endpoint {
+			return true
+		}
+	}
+	return false
+}
+
+// writeFailures reports the endpoints that failed the fan.
+func writeFailures(w http.ResponseWriter, failures []endpointFailure) {
+	names := make([]string, 0, len(failures))
+	for _, f := range failures {
+		names = append(names, f.Endpoint)
+	}
+	w.Header().Set(failedEndpointsHeader, strings.Join(names, ","))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadGateway)
+	json.NewEncoder(w).Encode(struct {
+		Error    string            `json:"error"`
+		Failures []endpointFailure `json:"failures"`
+	}{
+		Error:    "fanout failed",
+		Failures: failures,
+	})
+}
@@ -0,0 +1,150 @@
+package fanout
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/dfanout/dfanout/fanout/clientcache"
+	pb "github.com/dfanout/dfanout/proto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func (worker *Worker) doGRPC(r *http.Request, fanout string, endpoint *pb.Endpoint, grpcEndpoint *pb.GRPCEndpoint) (*workerResponse, error) {
+	conn, err := worker.clientCache.GRPCConn(fanout, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a connection: %w", err)
+	}
+
+	req, err := io.ReadAll(worker.newBodyReader(r))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), clientcache.Timeout(grpcEndpoint.TimeoutMs))
+	defer cancel()
+
+	// Set a header to avoid the fanout triggering itself.
+	// Don't remove this header.
+	md := metadata.Pairs(circularRequestDetectionHeader, fanout)
+	for _, h := range grpcEndpoint.Metadata {
+		md.Append(h.Key, h.Values...)
+	}
+	secret := make(http.Header)
+	if err := worker.handler.addSecretHeaders(ctx, secret, grpcEndpoint.Metadata); err != nil {
+		return nil, err
+	}
+	for k, vv := range secret {
+		md.Append(k, vv...)
+	}
+	trace := make(http.Header)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(trace))
+	for k, vv := range trace {
+		md.Set(k, vv...)
+	}
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	var (
+		resp    []byte
+		header  metadata.MD
+		trailer metadata.MD
+	)
+	err = conn.Invoke(ctx, grpcEndpoint.Method, &req, &resp,
+		grpc.ForceCodec(rawCodec{}),
+		grpc.Header(&header),
+		grpc.Trailer(&trailer),
+	)
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, err
+	}
+	if st.Code() == codes.Unavailable || st.Code() == codes.DeadlineExceeded {
+		// Connection-level failures are reported as errors,
+		// similar to HTTP transport errors.
+		return nil, err
+	}
+
+	h := make(http.Header)
+	for k, vv := range header {
+		for _, v := range vv {
+			h.Add(k, v)
+		}
+	}
+	h.Set("Content-Type", "application/protobuf")
+	h.Set("Grpc-Status", strconv.Itoa(int(st.Code())))
+	if msg := st.Message(); msg != "" {
+		h.Set("Grpc-Message", msg)
+	}
+	return &workerResponse{
+		code:   httpStatusFromCode(st.Code()),
+		header: h,
+		body:   io.NopCloser(bytes.NewReader(resp)),
+		size:   int64(len(resp)),
+	}, nil
+}
+
+// rawCodec passes through serialized messages, so fanouts
+// don't need the descriptors of the services they call.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v any) ([]byte, error) {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return nil, fmt.Errorf("rawCodec: unexpected type %T", v)
+	}
+	return *b, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v any) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("rawCodec: unexpected type %T", v)
+	}
+	*b = append((*b)[:0], data...)
+	return nil
+}
+
+// Name returns "proto", so servers see the default
+// "application/grpc+proto" content type.
+func (rawCodec) Name() string {
+	return "proto"
+}
+
+// httpStatusFromCode maps gRPC status codes to HTTP status codes
+// as documented in https://cloud.google.com/apis/design/errors.
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499 // Client Closed Request
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -1,28 +1,78 @@
 package fanout
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
+	"github.com/dfanout/dfanout/auth"
 	"github.com/dfanout/dfanout/debug"
 	"github.com/dfanout/dfanout/fanout/clientcache"
+	"github.com/dfanout/dfanout/fanout/compare"
+	"github.com/dfanout/dfanout/fanout/outbox"
+	"github.com/dfanout/dfanout/fanout/tee"
+	"github.com/dfanout/dfanout/logging"
+	"github.com/dfanout/dfanout/metrics"
 	pb "github.com/dfanout/dfanout/proto"
+	"github.com/dfanout/dfanout/ratelimit"
+	"github.com/dfanout/dfanout/secrets"
 	"github.com/gorilla/mux"
+	"github.com/twitchtv/twirp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 type Handler struct {
 	ClientCache *clientcache.Cache
 	FanoutCache *Cache
+
+	// Body contains the server defaults to buffer inbound
+	// request bodies. Fanouts can override the limits.
+	Body tee.Options
+
+	// Mismatches records the results of comparing responses of
+	// non-primary endpoints to the primary response. If nil,
+	// responses are not compared.
+	Mismatches *compare.Store
+
+	// Background sends the requests to the endpoints fans don't
+	// wait for. If nil, fans wait for all endpoints.
+	Background *Background
+
+	// MaxOutboundRequests limits the concurrent requests to
+	// endpoints across fanouts. If zero, requests are not limited.
+	MaxOutboundRequests int
+
+	// RateLimiter enforces the fanouts' rate limits across
+	// the cluster. If nil, requests are not rate limited.
+	RateLimiter *ratelimit.Limiter
+
+	// Secrets resolves the secrets referenced by the fanouts.
+	Secrets *secrets.Store
+
+	// Outbox persists the failed requests to durable endpoints.
+	// If nil, failed requests aren't persisted.
+	Outbox *outbox.Outbox
+
+	stats          stats
+	authenticators authenticators
+	breakers       breakers
+	limiters       limiters
+	inFlight       limiters  // of outbound rate limits
+	latencies      latencies // of hedged fanouts
 }
 
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r = logging.Request(w, r)
 	vars := mux.Vars(r)
 	fanout := vars["name"]
-	log.Printf("Serving fanout = %q", fanout)
 
 	if fanout == "" {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -38,50 +88,213 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	endpoints, err := h.FanoutCache.Fanout(r.Context(), fanout)
+	h.serveFanout(w, r, fanout)
+}
+
+func (h *Handler) serveFanout(w http.ResponseWriter, r *http.Request, fanout string) {
+	logger := logging.FromContext(r.Context()).With(logging.Fanout, fanout)
+	resp, err := h.FanoutCache.Fanout(r.Context(), fanout)
 	if err != nil {
+		logger.Error("Cannot retrieve the fanout", logging.Error, err)
 		w.WriteHeader(http.StatusInternalServerError)
 		fmt.Fprintf(w, "cannot retrieve the fanout: %v", err)
 		return
 	}
 
 	if r.URL.Query().Has("debug") {
-		debug.NewHandler(fanout, endpoints).ServeHTTP(w, r)
+		if r.URL.Query().Get("debug") == "preview" {
+			h.servePreview(w, r, fanout, resp)
+			return
+		}
+		if h.authenticate(w, r, fanout, resp.Config.GetInboundAuth(), nil) == nil {
+			return
+		}
+		debug.NewHandler(fanout, resp.Endpoints, h.debugStatus(fanout, resp.Endpoints)).ServeHTTP(w, r)
+		return
+	}
+
+	ctx, span := startServerSpan(r, fanout)
+	defer span.End()
+	r = r.WithContext(ctx)
+
+	// Only report metrics for existing fanouts
+	// to avoid unbounded label values.
+	start := time.Now()
+	inFlight := metrics.InboundInFlight.WithLabelValues(fanout)
+	inFlight.Inc()
+	sw := &statusWriter{ResponseWriter: w}
+	w = sw
+	defer func() {
+		took := time.Since(start)
+		endServerSpan(span, sw.status())
+		inFlight.Dec()
+		metrics.InboundRequests.WithLabelValues(fanout, strconv.Itoa(sw.status())).Inc()
+		metrics.InboundLatency.WithLabelValues(fanout).Observe(took.Seconds())
+		logger.Info("Served fanout",
+			logging.Method, r.Method,
+			logging.URL, r.URL.String(),
+			logging.Status, sw.status(),
+			logging.Bytes, sw.bytes,
+			logging.Took(took),
+		)
+	}()
+
+	if h.rateLimited(w, r, fanout, resp.Config.GetRateLimit()) {
+		return
+	}
+
+	// Read the body once, so it can be replayed to every endpoint.
+	bodyConfig := resp.Config.GetBody()
+	body, err := tee.New(r.Body, h.bodyOptions(bodyConfig))
+	var primaryOnly bool
+	switch {
+	case err == tee.ErrTooLarge && bodyConfig.GetOversizedPolicy() == pb.OversizedBodyPolicy_OVERSIZED_BODY_PRIMARY_ONLY:
+		logger.Warn("Body is too large to mirror; only serving the primary endpoint", logging.Bytes, body.Size())
+		primaryOnly = true
+	case err == tee.ErrTooLarge:
+		body.Close()
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		fmt.Fprintln(w, "request body is too large")
+		return
+	case err != nil:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "cannot read the request body: %v", err)
+		return
+	}
+	defer body.Close()
+	if primaryOnly && len(resp.Config.GetInboundAuth().GetHmacKeys()) > 0 && r.Header.Get(auth.SignatureHeader) != "" {
+		// The signature can't be verified without the whole body.
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		fmt.Fprintln(w, "request body is too large to verify its signature")
+		return
+	}
+	authReq := h.authenticate(w, r, fanout, resp.Config.GetInboundAuth(), body)
+	if authReq == nil {
 		return
 	}
+	r = authReq
 
 	worker := &Worker{
 		fanout:      fanout,
-		endpoints:   endpoints,
+		config:      resp.Config,
+		endpoints:   resp.Endpoints,
+		handler:     h,
 		clientCache: h.ClientCache,
+		logger:      logger,
+		body:        body,
+		primaryOnly: primaryOnly,
+	}
+	if ms := resp.Config.GetTimeoutMs(); ms > 0 {
+		ctx, cancel := context.WithTimeout(r.Context(), time.Duration(ms)*time.Millisecond)
+		defer cancel()
+		r = r.WithContext(ctx)
 	}
 	worker.Wait(w, r)
 }
 
+func (h *Handler) bodyOptions(c *pb.BodyConfig) tee.Options {
+	opts := h.Body
+	if v := c.GetMemoryLimitBytes(); v > 0 {
+		opts.MemoryLimit = v
+	}
+	if v := c.GetMaxBytes(); v > 0 {
+		opts.MaxSize = v
+	}
+	return opts
+}
+
 type Worker struct {
 	fanout             string
+	config             *pb.FanoutConfig
+	handler            *Handler // serves fanout endpoints
 	clientCache        *clientcache.Cache
 	endpoints          []*pb.Endpoint
 	maxEndpointTimeout time.Duration
+	logger             *slog.Logger // logs the fanout's request
+
+	// body is the buffered inbound request body. If primaryOnly
+	// is set, the body was too large to be buffered entirely and
+	// only the primary endpoint is served.
+	body        *tee.Body
+	primaryOnly bool
+
+	// decoded is the JSON decoded body, decoded
+	// once when endpoints are sampled by a JSON field.
+	decoded     any
+	decodedBody bool
 
 	resp *workerResponse // mutated by the primary response
 }
 
 func (worker *Worker) Wait(w http.ResponseWriter, r *http.Request) {
-	// TODO: Set a cap on maximum number of concurrent outgoing requests.
-	// Fail when primary endpoint fails.
-	var wg sync.WaitGroup
-	wg.Add(len(worker.endpoints))
+	if worker.hedging() {
+		worker.hedge(w, r)
+		return
+	}
 
-	for _, endpoint := range worker.endpoints {
-		go func(e *pb.Endpoint) {
+	results := make([]endpointResult, len(worker.endpoints))
+	background := make([]endpointResult, len(worker.endpoints))
+
+	var wg, bg sync.WaitGroup
+	var detached bool
+	for i, endpoint := range worker.endpoints {
+		// Endpoints can't be sent bodies too large to be buffered.
+		if (worker.primaryOnly && !endpoint.Primary) || !matches(r, endpoint) || !worker.sampled(r, endpoint) {
+			results[i].skipped = true
+			continue
+		}
+		if worker.detached(endpoint) {
+			// Background results don't decide the fan's outcome.
+			results[i].skipped = true
+			worker.detach(r, i, endpoint, &bg, background)
+			detached = true
+			continue
+		}
+		wg.Add(1)
+		go func(i int, e *pb.Endpoint) {
 			defer wg.Done()
 
-			worker.do(r, worker.fanout, e)
-		}(endpoint)
+			results[i] = worker.do(r, worker.fanout, e)
+		}(i, endpoint)
 	}
 	wg.Wait()
 
+	if worker.comparing() {
+		if detached {
+			// Compare once the background endpoints respond. The
+			// primary response is captured, so it can be served now.
+			merged := append([]endpointResult(nil), results...)
+			go func() {
+				bg.Wait()
+				for i, e := range worker.endpoints {
+					if worker.detached(e) {
+						merged[i] = background[i]
+					}
+				}
+				worker.compare(merged)
+			}()
+		} else {
+			worker.compare(results)
+		}
+	}
+	if failures := worker.failures(results); len(failures) > 0 {
+		if worker.resp != nil {
+			worker.resp.Close()
+		}
+		if worker.writeRateLimited(w, results) {
+			return
+		}
+		writeFailures(w, failures)
+		return
+	}
+	if worker.aggregating() {
+		if worker.resp != nil {
+			worker.resp.Close() // the primary isn't aggregated
+		}
+		worker.writeAggregate(w, results)
+		return
+	}
+
 	if worker.resp == nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		fmt.Fprintln(w, "timed out with no response")
@@ -94,22 +307,139 @@ func (worker *Worker) Wait(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (worker *Worker) do(r *http.Request, fanout string, endpoint *pb.Endpoint) {
-	log.Printf("Making a request to = %q/%q", fanout, endpoint.Name)
-	defer log.Printf("Done with a request to = %q/%q", fanout, endpoint.Name)
+func (worker *Worker) do(r *http.Request, fanout string, endpoint *pb.Endpoint) endpointResult {
+	resp, result := worker.call(r, fanout, endpoint)
+	if resp == nil {
+		return result
+	}
+	if worker.comparing() {
+		result.captured = worker.capture(resp)
+	}
+	if worker.aggregated(endpoint) {
+		result.body, result.bodyErr = worker.readAggregated(resp)
+		resp.Close()
+		return result
+	}
+	if !endpoint.Primary {
+		resp.Close() // discard the response
+		return result
+	}
+	worker.resp = resp
+	return result
+}
+
+// call makes a request to the endpoint, retrying it according to the
+// endpoint's retry policy, and records the outcome. The response is
+// nil if the request failed.
+func (worker *Worker) call(r *http.Request, fanout string, endpoint *pb.Endpoint) (*workerResponse, endpointResult) {
+	ctx, span := startClientSpan(r.Context(), fanout, endpoint)
+	defer span.End()
+	r = r.WithContext(ctx)
+
+	start := time.Now()
+	var (
+		resp     *workerResponse
+		attempts int
+	)
+	proxyReq, err := worker.applyTransform(worker.callerHeaders(r, endpoint), endpoint)
+	if err == nil {
+		r = proxyReq
+		resp, attempts, err = worker.doWithBreaker(r, fanout, endpoint)
+	}
+	endClientSpan(span, attempts, resp, err)
+	worker.logEndpoint(r, endpoint, attempts, time.Since(start), resp, err)
+	worker.handler.stats.record(fanout, endpoint.Name, attempts, err)
+	if err != nil {
+		metrics.OutboundErrors.WithLabelValues(fanout, endpoint.Name).Inc()
+	}
+	result := endpointResult{err: err}
+	if resp != nil {
+		result.code = resp.code
+	}
+	// Requests that can't be transformed are never sent, and would
+	// fail again. Requests failed by an open breaker or a concurrency
+	// limit are deferred, and so are the requests over an outbound
+	// rate limit if the endpoint defers them.
+	sent := attempts > 0 || errors.Is(err, errCircuitOpen) || errors.Is(err, errLimited)
+	switch reason := result.failed(); {
+	case reason == "":
+	case errors.Is(err, errRateLimited):
+		if worker.deferrable(endpoint) {
+			worker.enqueue(r, fanout, endpoint, reason)
+		}
+	case sent && worker.durable(endpoint):
+		worker.enqueue(r, fanout, endpoint, reason)
+	}
+	if err != nil {
+		return nil, result
+	}
+	return resp, result
+}
+
+// send makes a request to the endpoint's destination.
+func (worker *Worker) send(r *http.Request, fanout string, endpoint *pb.Endpoint) (*workerResponse, error) {
+	switch d := endpoint.Destination.(type) {
+	case *pb.Endpoint_HttpEndpoint:
+		return worker.doHTTP(r, fanout, endpoint, d.HttpEndpoint)
+	case *pb.Endpoint_GrpcEndpoint:
+		return worker.doGRPC(r, fanout, endpoint, d.GrpcEndpoint)
+	case *pb.Endpoint_TwirpEndpoint:
+		return worker.doTwirp(r, fanout, endpoint, d.TwirpEndpoint)
+	case *pb.Endpoint_FanoutEndpoint:
+		return worker.doFanout(r, fanout, endpoint, d.FanoutEndpoint)
+	default:
+		return nil, fmt.Errorf("unsupported destination %T", d)
+	}
+}
+
+func (worker *Worker) doHTTP(r *http.Request, fanout string, endpoint *pb.Endpoint, httpEndpoint *pb.HTTPEndpoint) (*workerResponse, error) {
+	proxyReq, err := worker.newHTTPRequest(r, fanout, httpEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := worker.clientCache.HTTPClient(fanout, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a client: %w", err)
+	}
 
-	httpEndpoint := endpoint.Destination.(*pb.Endpoint_HttpEndpoint).HttpEndpoint
+	resp, err := client.Do(proxyReq)
+	if err != nil {
+		return nil, err
+	}
+	return &workerResponse{
+		code:   resp.StatusCode,
+		header: resp.Header,
+		body:   resp.Body,
+		size:   resp.ContentLength,
+	}, nil
+}
 
+// newHTTPRequest creates the request to an HTTP endpoint.
+func (worker *Worker) newHTTPRequest(r *http.Request, fanout string, httpEndpoint *pb.HTTPEndpoint) (*http.Request, error) {
 	method := r.Method
 	if m := httpEndpoint.Method; m != "" {
 		method = m
 	}
-	proxyReq, err := http.NewRequest(method, httpEndpoint.Url, r.Body)
+	url, err := httpURL(r, httpEndpoint)
 	if err != nil {
-		log.Printf("Failed to create a request for %q/%q; err = %q", fanout, endpoint.Name, err)
-		return
+		return nil, err
+	}
+	proxyReq, err := http.NewRequestWithContext(r.Context(), method, url, worker.newBodyReader(r))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a request: %w", err)
 	}
+	worker.setContentLength(proxyReq, r)
+	setHeaders(proxyReq, r, fanout, httpEndpoint.Header)
+	if err := worker.handler.addSecretHeaders(r.Context(), proxyReq.Header, httpEndpoint.Header); err != nil {
+		return nil, err
+	}
+	return proxyReq, nil
+}
 
+// setHeaders sets the headers of a request to an endpoint, from the
+// inbound request and from the endpoint's config.
+func setHeaders(proxyReq, r *http.Request, fanout string, headers []*pb.Header) {
 	// Set a header to avoid the fanout triggering itself.
 	// Don't remove this header.
 	proxyReq.Header.Set(circularRequestDetectionHeader, fanout)
@@ -118,55 +448,141 @@ func (worker *Worker) do(r *http.Request, fanout string, endpoint *pb.Endpoint)
 			proxyReq.Header.Add(key, v)
 		}
 	}
-	for _, h := range httpEndpoint.Header {
+	for _, h := range headers {
 		for _, v := range h.Values {
 			proxyReq.Header.Add(h.Key, v)
 		}
 	}
+	// Propagate the trace context of the endpoint's span,
+	// replacing the inbound trace context.
+	otel.GetTextMapPropagator().Inject(proxyReq.Context(), propagation.HeaderCarrier(proxyReq.Header))
+}
 
-	client, err := worker.clientCache.HTTPClient(fanout, endpoint)
-	if err != nil {
-		log.Printf("Failed to create a client for %q/%q; err = %q", fanout, endpoint.Name, err)
+func (worker *Worker) setContentLength(proxyReq, r *http.Request) {
+	if body, ok := transformedBody(r.Context()); ok {
+		proxyReq.ContentLength = int64(len(body))
 		return
 	}
+	if worker.primaryOnly {
+		proxyReq.ContentLength = r.ContentLength
+	} else {
+		proxyReq.ContentLength = worker.body.Size()
+	}
+}
 
-	resp, err := client.Do(proxyReq)
-	if err != nil {
-		log.Printf("Failed a request to = %q/%q; err = %q", fanout, endpoint.Name, err)
-		return
+// newBodyReader returns a new reader for the inbound request body.
+func (worker *Worker) newBodyReader(r *http.Request) io.Reader {
+	if body, ok := transformedBody(r.Context()); ok {
+		return bytes.NewReader(body)
 	}
-	if !endpoint.Primary {
-		resp.Body.Close() // discard the response
-		return
+	if worker.primaryOnly {
+		// Only the primary endpoint is served, it can consume
+		// the rest of the inbound body.
+		return worker.body.Remainder(r.Body)
 	}
-	worker.resp = &workerResponse{
-		code:   resp.StatusCode,
-		header: resp.Header,
-		body:   resp.Body,
+	if worker.body.Size() == 0 {
+		return http.NoBody
 	}
+	return worker.body.NewReader()
 }
 
 type workerResponse struct {
 	code   int
 	header http.Header
 	body   io.ReadCloser
+
+	// size is the size of the body in bytes,
+	// or -1 if it is unknown.
+	size int64
+
+	// twerr is set if the endpoint is a Twirp
+	// endpoint and responded with an error.
+	twerr twirp.Error
+}
+
+// Close discards the response.
+func (r *workerResponse) Close() error {
+	if r.body != nil {
+		return r.body.Close()
+	}
+	return nil
 }
 
 // Copy copies the worker's primary endpoint response
 // to the fanout handler's response.
 func (r *workerResponse) Copy(w http.ResponseWriter) error {
-	w.WriteHeader(r.code)
+	if r.twerr != nil {
+		// Relay Twirp errors as Twirp errors, so Twirp
+		// clients can handle them.
+		return twirp.WriteError(w, r.twerr)
+	}
 	for k, vv := range r.header {
 		for _, v := range vv {
 			w.Header().Add(k, v)
 		}
 	}
+	w.WriteHeader(r.code)
 	if r.body != nil {
 		defer r.body.Close()
-		_, err := io.Copy(w, r.body)
+		var dst io.Writer = w
+		if f, ok := w.(http.Flusher); ok && r.size < 0 {
+			// Stream responses of unknown length, such
+			// as server-sent events, as they arrive.
+			dst = flushWriter{w, f}
+		}
+		_, err := io.Copy(dst, r.body)
 		return err
 	}
 	return nil
 }
 
+// flushWriter flushes after every write.
+type flushWriter struct {
+	io.Writer
+	http.Flusher
+}
+
+func (w flushWriter) Write(b []byte) (int, error) {
+	n, err := w.Writer.Write(b)
+	w.Flush()
+	return n, err
+}
+
+// statusWriter records the status code and
+// the number of bytes written of a response.
+type statusWriter struct {
+	http.ResponseWriter
+	code  int
+	bytes int64
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	if w.code == 0 {
+		w.code = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += int64(n)
+	return n, err
+}
+
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *statusWriter) status() int {
+	if w.code == 0 {
+		return http.StatusOK
+	}
+	return w.code
+}
+
 const circularRequestDetectionHeader = "DFanout-Fanout"
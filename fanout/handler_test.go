@@ -0,0 +1,96 @@
+package fanout
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/gorilla/mux"
+)
+
+func TestOversizedBody(t *testing.T) {
+	b := &backend{}
+	srv := httptest.NewServer(b)
+	defer srv.Close()
+
+	endpoint := func(name string, primary bool, mode pb.FailureMode) *pb.Endpoint {
+		return &pb.Endpoint{Name: name, Primary: primary, FailureMode: mode, Destination: &pb.Endpoint_HttpEndpoint{
+			HttpEndpoint: &pb.HTTPEndpoint{Url: srv.URL + "/ok/" + name},
+		}}
+	}
+	endpoints := []*pb.Endpoint{
+		endpoint("p", true, pb.FailureMode_FAILURE_MODE_BEST_EFFORT),
+		endpoint("r", false, pb.FailureMode_FAILURE_MODE_REQUIRED),
+		endpoint("q", false, pb.FailureMode_FAILURE_MODE_QUORUM),
+	}
+	tests := []struct {
+		name   string
+		policy pb.OversizedBodyPolicy
+		body   string
+		status int
+		called []string
+	}{
+		{
+			name:   "small body",
+			policy: pb.OversizedBodyPolicy_OVERSIZED_BODY_PRIMARY_ONLY,
+			body:   "abc",
+			status: http.StatusOK,
+			called: []string{"p", "q", "r"},
+		},
+		{
+			name:   "reject",
+			policy: pb.OversizedBodyPolicy_OVERSIZED_BODY_REJECT,
+			body:   "0123456789",
+			status: http.StatusRequestEntityTooLarge,
+		},
+		{
+			// Required and quorum endpoints are skipped, not failed.
+			name:   "primary only",
+			policy: pb.OversizedBodyPolicy_OVERSIZED_BODY_PRIMARY_ONLY,
+			body:   "0123456789",
+			status: http.StatusOK,
+			called: []string{"p"},
+		},
+	}
+
+	fanouts := make(map[string]*pb.GetFanoutResponse)
+	run := testRuns.Add(1)
+	name := func(i int) string {
+		return fmt.Sprintf("oversized-%d-%d", run, i)
+	}
+	for i, tt := range tests {
+		fanouts[name(i)] = &pb.GetFanoutResponse{
+			Endpoints: endpoints,
+			Config: &pb.FanoutConfig{Body: &pb.BodyConfig{
+				MemoryLimitBytes: 4,
+				MaxBytes:         4,
+				OversizedPolicy:  tt.policy,
+			}},
+		}
+	}
+	r := mux.NewRouter()
+	r.Handle("/fanout/{name}", newTestHandler(fanouts))
+	front := httptest.NewServer(r)
+	defer front.Close()
+
+	for i, tt := range tests {
+		b.reset()
+		resp, err := http.Post(front.URL+"/fanout/"+name(i), "text/plain", strings.NewReader(tt.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != tt.status {
+			t.Errorf("%s: status = %d, want %d: %s", tt.name, resp.StatusCode, tt.status, body)
+		}
+		if called, _ := b.calls(); !slices.Equal(called, tt.called) {
+			t.Errorf("%s: called %q, want %q", tt.name, called, tt.called)
+		}
+	}
+}
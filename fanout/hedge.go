@@ -0,0 +1,245 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dfanout/dfanout/metrics"
+	pb "github.com/dfanout/dfanout/proto"
+)
+
+const (
+	defaultHedgeDelay = 100 * time.Millisecond
+
+	// latencySamples is the number of recent primary
+	// latencies kept to compute hedge delays.
+	latencySamples = 256
+
+	// minLatencySamples is the number of samples needed
+	// to use a latency percentile as the hedge delay.
+	minLatencySamples = 20
+)
+
+// hedging reports whether the fan hedges requests.
+func (worker *Worker) hedging() bool {
+	return worker.config.GetMode() == pb.FanoutMode_FANOUT_MODE_HEDGE
+}
+
+// errHedgeLost is the cause of cancelling the requests
+// of a hedged fan that didn't respond first.
+var errHedgeLost = errors.New("another endpoint responded first")
+
+type hedgeResult struct {
+	i      int // index of the endpoint
+	resp   *workerResponse
+	result endpointResult
+}
+
+// hedge sends the request to the primary endpoint and hedges it to
+// the other endpoints until an endpoint responds successfully.
+func (worker *Worker) hedge(w http.ResponseWriter, r *http.Request) {
+	candidates := worker.hedgeCandidates(r)
+	delay := worker.hedgeDelay()
+
+	results := make(chan hedgeResult, len(candidates))
+	cancels := make(map[int]context.CancelCauseFunc, len(candidates))
+	send := func(i int) {
+		ctx, cancel := context.WithCancelCause(r.Context())
+		cancels[i] = cancel
+		e := worker.endpoints[i]
+		if !e.Primary {
+			metrics.Hedges.WithLabelValues(worker.fanout, e.Name).Inc()
+		}
+		go func() {
+			start := time.Now()
+			resp, result := worker.call(r.WithContext(ctx), worker.fanout, e)
+			// A primary cancelled because it lost to a hedge is recorded
+			// with the time until it was cancelled, a lower bound of its
+			// latency, so slow primaries keep the percentile up.
+			lost := errors.Is(context.Cause(ctx), errHedgeLost)
+			if e.Primary && (result.failed() == "" || lost) {
+				worker.handler.latencies.record(worker.fanout, time.Since(start))
+			}
+			results <- hedgeResult{i: i, resp: resp, result: result}
+		}()
+	}
+
+	send(candidates[0])
+	next, pending := 1, 1
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	var (
+		winner *hedgeResult
+		failed []hedgeResult
+	)
+	for winner == nil && pending > 0 {
+		select {
+		case hr := <-results:
+			pending--
+			if hr.result.failed() == "" {
+				winner = &hr
+				break
+			}
+			failed = append(failed, hr)
+			if next < len(candidates) {
+				// Don't wait for the delay to hedge failures.
+				send(candidates[next])
+				next++
+				pending++
+				timer.Reset(delay)
+			}
+		case <-timer.C:
+			if next < len(candidates) {
+				send(candidates[next])
+				next++
+				pending++
+				timer.Reset(delay)
+			}
+		}
+	}
+
+	// Cancel the losers, and discard their responses.
+	for i, cancel := range cancels {
+		if winner == nil || i != winner.i {
+			cancel(errHedgeLost)
+		}
+	}
+	go func(pending int) {
+		for ; pending > 0; pending-- {
+			if hr := <-results; hr.resp != nil {
+				hr.resp.Close()
+			}
+		}
+	}(pending)
+
+	if winner != nil {
+		defer cancels[winner.i](nil)
+		metrics.HedgeWins.WithLabelValues(worker.fanout, worker.endpoints[winner.i].Name).Inc()
+		for _, hr := range failed {
+			if hr.resp != nil {
+				hr.resp.Close()
+			}
+		}
+		if err := winner.resp.Copy(w); err != nil {
+			fmt.Fprintf(w, "failed to serve body: %v", err)
+		}
+		return
+	}
+
+	// All requests failed. Serve the primary's
+	// response as it is if there is one.
+	var (
+		primary  *workerResponse
+		failures []endpointFailure
+	)
+	for _, hr := range failed {
+		e := worker.endpoints[hr.i]
+		if e.Primary && hr.resp != nil {
+			primary = hr.resp
+			continue
+		}
+		if hr.resp != nil {
+			hr.resp.Close()
+		}
+		failures = append(failures, endpointFailure{Endpoint: e.Name, Reason: hr.result.failed()})
+	}
+	if primary != nil {
+		if err := primary.Copy(w); err != nil {
+			fmt.Fprintf(w, "failed to serve body: %v", err)
+		}
+		return
+	}
+	writeFailures(w, failures)
+}
+
+// hedgeCandidates returns the indexes of the endpoints the request
+// can be sent to, starting with the primary endpoint. Only idempotent
+// requests are hedged, since all the candidates may receive them.
+func (worker *Worker) hedgeCandidates(r *http.Request) []int {
+	var primary int
+	var hedges []int
+	for i, e := range worker.endpoints {
+		switch {
+		case e.Primary:
+			primary = i
+		case worker.primaryOnly:
+			// The body can't be replayed.
+		case matches(r, e) && worker.sampled(r, e) && idempotent(r, e):
+			hedges = append(hedges, i)
+		}
+	}
+	if !idempotent(r, worker.endpoints[primary]) {
+		return []int{primary}
+	}
+	if max := int(worker.config.GetHedge().GetMaxHedges()); max > 0 && len(hedges) > max {
+		hedges = hedges[:max]
+	}
+	return append([]int{primary}, hedges...)
+}
+
+// hedgeDelay returns the delay before hedging a request.
+func (worker *Worker) hedgeDelay() time.Duration {
+	c := worker.config.GetHedge()
+	delay := defaultHedgeDelay
+	if ms := c.GetDelayMs(); ms > 0 {
+		delay = time.Duration(ms) * time.Millisecond
+	}
+	if p := c.GetLatencyPercentile(); p > 0 {
+		if d, ok := worker.handler.latencies.percentile(worker.fanout, p); ok {
+			delay = d
+		}
+	}
+	return delay
+}
+
+// latencies keeps the recent latencies of the
+// primary endpoints of the hedged fanouts.
+type latencies struct {
+	fanouts sync.Map // string -> *latencyWindow
+}
+
+type latencyWindow struct {
+	mu      sync.Mutex
+	samples []time.Duration // ring buffer
+	next    int
+}
+
+func (l *latencies) record(fanout string, d time.Duration) {
+	v, _ := l.fanouts.LoadOrStore(fanout, &latencyWindow{})
+	window := v.(*latencyWindow)
+
+	window.mu.Lock()
+	defer window.mu.Unlock()
+	if len(window.samples) < latencySamples {
+		window.samples = append(window.samples, d)
+		return
+	}
+	window.samples[window.next] = d
+	window.next = (window.next + 1) % latencySamples
+}
+
+// percentile returns the p-th percentile of the fanout's
+// latencies, if there are enough samples.
+func (l *latencies) percentile(fanout string, p float64) (time.Duration, bool) {
+	v, ok := l.fanouts.Load(fanout)
+	if !ok {
+		return 0, false
+	}
+	window := v.(*latencyWindow)
+
+	window.mu.Lock()
+	samples := append([]time.Duration(nil), window.samples...)
+	window.mu.Unlock()
+	if len(samples) < minLatencySamples {
+		return 0, false
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	i := int(float64(len(samples)-1) * p / 100)
+	return samples[i], true
+}
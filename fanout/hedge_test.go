@@ -0,0 +1,280 @@
+package fanout
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dfanout/dfanout/fanout/clientcache"
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/gorilla/mux"
+)
+
+type fakeAdmin struct {
+	pb.AdminService
+
+	mu      sync.Mutex
+	fanouts map[string]*pb.GetFanoutResponse
+}
+
+func (a *fakeAdmin) GetFanout(ctx context.Context, req *pb.GetFanoutRequest) (*pb.GetFanoutResponse, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.fanouts[req.FanName], nil
+}
+
+var (
+	testAdmin     = &fakeAdmin{fanouts: make(map[string]*pb.GetFanoutResponse)}
+	testClients   = clientcache.New()
+	testCache     *Cache
+	testCacheOnce sync.Once
+
+	// testRuns numbers the runs of the tests, to name their fanouts.
+	testRuns atomic.Int64
+)
+
+// newTestHandler returns a handler serving the fanouts. Fanouts
+// are cached by name, so tests use a new name for each fanout.
+func newTestHandler(fanouts map[string]*pb.GetFanoutResponse) *Handler {
+	// A process can only have one cache.
+	testCacheOnce.Do(func() {
+		testCache = NewFanoutCache("127.0.0.1:0", nil, testClients, testAdmin, time.Minute)
+	})
+	testAdmin.mu.Lock()
+	defer testAdmin.mu.Unlock()
+	for name, f := range fanouts {
+		testAdmin.fanouts[name] = f
+	}
+	return &Handler{ClientCache: testClients, FanoutCache: testCache}
+}
+
+// backend serves "/ok/<name>", "/fail/<name>" and "/slow/<name>",
+// which responds after a second, and records the calls.
+type backend struct {
+	mu        sync.Mutex
+	called    []string
+	cancelled []string
+}
+
+func (b *backend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	behavior, name, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	b.mu.Lock()
+	b.called = append(b.called, name)
+	b.mu.Unlock()
+	switch behavior {
+	case "slow":
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+			b.mu.Lock()
+			b.cancelled = append(b.cancelled, name)
+			b.mu.Unlock()
+			return
+		}
+	case "fail":
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	io.WriteString(w, name)
+}
+
+func (b *backend) reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.called, b.cancelled = nil, nil
+}
+
+func (b *backend) calls() (called, cancelled []string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	called, cancelled = slices.Clone(b.called), slices.Clone(b.cancelled)
+	slices.Sort(called)
+	slices.Sort(cancelled)
+	return called, cancelled
+}
+
+func TestHedge(t *testing.T) {
+	b := &backend{}
+	srv := httptest.NewServer(b)
+	defer srv.Close()
+
+	endpoint := func(path string, primary bool) *pb.Endpoint {
+		_, name, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+		return &pb.Endpoint{Name: name, Primary: primary, Destination: &pb.Endpoint_HttpEndpoint{
+			HttpEndpoint: &pb.HTTPEndpoint{Url: srv.URL + path},
+		}}
+	}
+	hedge := func(c *pb.HedgeConfig, endpoints ...*pb.Endpoint) *pb.GetFanoutResponse {
+		return &pb.GetFanoutResponse{
+			Endpoints: endpoints,
+			Config:    &pb.FanoutConfig{Mode: pb.FanoutMode_FANOUT_MODE_HEDGE, Hedge: c},
+		}
+	}
+	tests := []struct {
+		name   string
+		method string
+		fanout *pb.GetFanoutResponse
+		want   string
+		status int
+
+		// Endpoints called and cancelled, sorted.
+		called    []string
+		cancelled []string
+
+		// Whether the primary's latency is recorded.
+		latency bool
+	}{
+		{
+			name:    "fast primary",
+			fanout:  hedge(&pb.HedgeConfig{DelayMs: 200}, endpoint("/ok/p", true), endpoint("/ok/a", false)),
+			want:    "p",
+			called:  []string{"p"},
+			latency: true,
+		},
+		{
+			name:      "slow primary",
+			fanout:    hedge(&pb.HedgeConfig{DelayMs: 20}, endpoint("/slow/p", true), endpoint("/ok/a", false)),
+			want:      "a",
+			called:    []string{"a", "p"},
+			cancelled: []string{"p"},
+			latency:   true,
+		},
+		{
+			name: "failures are hedged without the delay",
+			fanout: hedge(&pb.HedgeConfig{DelayMs: 10000},
+				endpoint("/fail/p", true), endpoint("/fail/a", false), endpoint("/ok/b", false)),
+			want:   "b",
+			called: []string{"a", "b", "p"},
+		},
+		{
+			name: "all failed",
+			fanout: hedge(&pb.HedgeConfig{DelayMs: 10000},
+				endpoint("/fail/p", true), endpoint("/fail/a", false)),
+			status: http.StatusInternalServerError,
+			want:   "p",
+			called: []string{"a", "p"},
+		},
+		{
+			name: "max hedges",
+			fanout: hedge(&pb.HedgeConfig{DelayMs: 10000, MaxHedges: 1},
+				endpoint("/fail/p", true), endpoint("/fail/a", false), endpoint("/ok/b", false)),
+			status: http.StatusInternalServerError,
+			want:   "p",
+			called: []string{"a", "p"},
+		},
+		{
+			name:   "non-idempotent requests aren't hedged",
+			method: http.MethodPost,
+			fanout: hedge(&pb.HedgeConfig{DelayMs: 20},
+				endpoint("/slow/p", true), endpoint("/ok/a", false)),
+			want:    "p",
+			called:  []string{"p"},
+			latency: true,
+		},
+	}
+
+	fanouts := make(map[string]*pb.GetFanoutResponse)
+	run := testRuns.Add(1)
+	name := func(i int) string {
+		return fmt.Sprintf("hedge-%d-%d", run, i)
+	}
+	for i, tt := range tests {
+		fanouts[name(i)] = tt.fanout
+	}
+	h := newTestHandler(fanouts)
+	r := mux.NewRouter()
+	r.Handle("/fanout/{name}", h)
+	front := httptest.NewServer(r)
+	defer front.Close()
+
+	for i, tt := range tests {
+		b.reset()
+		method := tt.method
+		if method == "" {
+			method = http.MethodGet
+		}
+		req, err := http.NewRequest(method, front.URL+"/fanout/"+name(i), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		start := time.Now()
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("%s: took %v", tt.name, elapsed)
+		}
+		status := tt.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		if resp.StatusCode != status || string(body) != tt.want {
+			t.Errorf("%s: response = %d %q, want %d %q", tt.name, resp.StatusCode, body, status, tt.want)
+		}
+		// Cancelled requests are seen by the backend shortly after.
+		deadline := time.Now().Add(time.Second)
+		for {
+			called, cancelled := b.calls()
+			_, latency := h.latencies.fanouts.Load(name(i))
+			if slices.Equal(called, tt.called) && slices.Equal(cancelled, tt.cancelled) && latency == tt.latency {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Errorf("%s: called %q and cancelled %q, want %q and %q", tt.name, called, cancelled, tt.called, tt.cancelled)
+				if latency != tt.latency {
+					t.Errorf("%s: primary latency recorded: %v, want %v", tt.name, latency, tt.latency)
+				}
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+}
+
+func TestLatencyPercentile(t *testing.T) {
+	var l latencies
+	if _, ok := l.percentile("f", 50); ok {
+		t.Error("percentile() of no samples is ok")
+	}
+	for i := 1; i < minLatencySamples; i++ {
+		l.record("f", time.Duration(i)*time.Millisecond)
+	}
+	if _, ok := l.percentile("f", 50); ok {
+		t.Errorf("percentile() of %d samples is ok", minLatencySamples-1)
+	}
+	for i := minLatencySamples; i <= 100; i++ {
+		l.record("f", time.Duration(i)*time.Millisecond)
+	}
+	tests := []struct {
+		p    float64
+		want time.Duration
+	}{
+		{p: 0, want: time.Millisecond},
+		{p: 50, want: 50 * time.Millisecond},
+		{p: 95, want: 95 * time.Millisecond},
+		{p: 100, want: 100 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		if got, ok := l.percentile("f", tt.p); !ok || got != tt.want {
+			t.Errorf("percentile(%v) = %v, %v, want %v", tt.p, got, ok, tt.want)
+		}
+	}
+
+	// Old samples are replaced.
+	for i := 0; i < latencySamples; i++ {
+		l.record("f", time.Second)
+	}
+	if got, _ := l.percentile("f", 0); got != time.Second {
+		t.Errorf("percentile(0) = %v after the window is replaced, want 1s", got)
+	}
+}
@@ -0,0 +1,112 @@
+// Package limiter implements a semaphore that limits concurrent
+// requests and grants the waiting high priority requests first.
+package limiter
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// Priority is the priority of a request.
+type Priority int
+
+const (
+	Low Priority = iota
+	High
+)
+
+// Limiter limits the number of concurrent requests.
+// It is safe for concurrent use.
+type Limiter struct {
+	limit int
+
+	mu      sync.Mutex
+	inUse   int
+	waiters [High + 1]list.List // of chan struct{}, by priority
+}
+
+// New returns a limiter that allows up to limit concurrent requests.
+func New(limit int) *Limiter {
+	return &Limiter{limit: limit}
+}
+
+// Limit returns the max number of concurrent requests.
+func (l *Limiter) Limit() int {
+	return l.limit
+}
+
+// InUse returns the number of requests holding a slot.
+func (l *Limiter) InUse() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inUse
+}
+
+// TryAcquire acquires a slot without waiting. It fails if there are
+// no free slots, or if there are requests of the same or a higher
+// priority waiting for a slot.
+func (l *Limiter) TryAcquire(p Priority) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.tryAcquire(p)
+}
+
+func (l *Limiter) tryAcquire(p Priority) bool {
+	if l.inUse >= l.limit {
+		return false
+	}
+	for q := p; q <= High; q++ {
+		if l.waiters[q].Len() > 0 {
+			return false
+		}
+	}
+	l.inUse++
+	return true
+}
+
+// Acquire acquires a slot, waiting until a slot is free or the
+// context is done. Waiting requests are granted slots in the order
+// of their priority, and in the order they arrived within a priority.
+func (l *Limiter) Acquire(ctx context.Context, p Priority) error {
+	l.mu.Lock()
+	if l.tryAcquire(p) {
+		l.mu.Unlock()
+		return nil
+	}
+	ready := make(chan struct{})
+	elem := l.waiters[p].PushBack(ready)
+	l.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		select {
+		case <-ready:
+			// Granted while giving up, pass the slot on.
+			l.mu.Unlock()
+			l.Release()
+		default:
+			l.waiters[p].Remove(elem)
+			l.mu.Unlock()
+		}
+		return ctx.Err()
+	}
+}
+
+// Release releases a slot acquired with Acquire or TryAcquire.
+func (l *Limiter) Release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inUse--
+	for p := High; p >= Low; p-- {
+		if front := l.waiters[p].Front(); front != nil {
+			l.waiters[p].Remove(front)
+			l.inUse++
+			close(front.Value.(chan struct{}))
+			return
+		}
+	}
+}
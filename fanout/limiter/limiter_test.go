@@ -0,0 +1,153 @@
+package limiter
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// waitForWaiters waits until n requests of priority p are waiting.
+func waitForWaiters(t *testing.T, l *Limiter, p Priority, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		l.mu.Lock()
+		waiting := l.waiters[p].Len()
+		l.mu.Unlock()
+		if waiting == n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d requests of priority %d are waiting, want %d", waiting, p, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestTryAcquire(t *testing.T) {
+	l := New(2)
+	for i := 0; i < 2; i++ {
+		if !l.TryAcquire(Low) {
+			t.Fatalf("TryAcquire() %d = false, want true", i)
+		}
+	}
+	if l.TryAcquire(High) {
+		t.Error("TryAcquire() over the limit = true, want false")
+	}
+	if got := l.InUse(); got != 2 {
+		t.Errorf("InUse() = %d, want 2", got)
+	}
+	l.Release()
+	if got := l.InUse(); got != 1 {
+		t.Errorf("InUse() after Release() = %d, want 1", got)
+	}
+	if !l.TryAcquire(Low) {
+		t.Error("TryAcquire() after Release() = false, want true")
+	}
+}
+
+func TestTryAcquireWithWaiters(t *testing.T) {
+	tests := []struct {
+		name    string
+		waiting Priority
+		try     Priority
+		want    bool
+	}{
+		// Requests can't skip the waiting requests of the same
+		// or a higher priority.
+		{name: "low waiting, low tries", waiting: Low, try: Low},
+		{name: "high waiting, low tries", waiting: High, try: Low},
+		{name: "high waiting, high tries", waiting: High, try: High},
+		{name: "low waiting, high tries", waiting: Low, try: High, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New(1)
+			l.TryAcquire(Low)
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan error)
+			go func() { done <- l.Acquire(ctx, tt.waiting) }()
+			waitForWaiters(t, l, tt.waiting, 1)
+
+			// Free a slot while the request is still waiting.
+			l.mu.Lock()
+			l.inUse--
+			l.mu.Unlock()
+			if got := l.TryAcquire(tt.try); got != tt.want {
+				t.Errorf("TryAcquire() = %v, want %v", got, tt.want)
+			}
+			cancel()
+			<-done
+		})
+	}
+}
+
+func TestAcquireOrder(t *testing.T) {
+	l := New(1)
+	if err := l.Acquire(context.Background(), Low); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		mu    sync.Mutex
+		order []string
+		wg    sync.WaitGroup
+	)
+	acquire := func(name string, p Priority) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := l.Acquire(context.Background(), p); err != nil {
+				t.Error(err)
+				return
+			}
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+			l.Release()
+		}()
+	}
+	acquire("low-1", Low)
+	waitForWaiters(t, l, Low, 1)
+	acquire("low-2", Low)
+	waitForWaiters(t, l, Low, 2)
+	acquire("high-1", High)
+	waitForWaiters(t, l, High, 1)
+	acquire("high-2", High)
+	waitForWaiters(t, l, High, 2)
+
+	l.Release()
+	wg.Wait()
+	// High priority requests first, then in the order they arrived.
+	want := []string{"high-1", "high-2", "low-1", "low-2"}
+	if !reflect.DeepEqual(order, want) {
+		t.Errorf("order = %v, want %v", order, want)
+	}
+	if got := l.InUse(); got != 0 {
+		t.Errorf("InUse() = %d, want 0", got)
+	}
+}
+
+func TestAcquireCancel(t *testing.T) {
+	l := New(1)
+	l.TryAcquire(High)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Acquire(ctx, High); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Acquire() = %v, want context.DeadlineExceeded", err)
+	}
+	// The request that gave up doesn't wait anymore,
+	// and the slot isn't granted to it.
+	waitForWaiters(t, l, High, 0)
+	l.Release()
+	if got := l.InUse(); got != 0 {
+		t.Errorf("InUse() = %d, want 0", got)
+	}
+	if !l.TryAcquire(Low) {
+		t.Error("TryAcquire() = false, want true")
+	}
+}
@@ -0,0 +1,130 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dfanout/dfanout/fanout/limiter"
+	"github.com/dfanout/dfanout/metrics"
+	pb "github.com/dfanout/dfanout/proto"
+)
+
+var errLimited = errors.New("concurrency limit reached")
+
+// limiters keeps the concurrency limiters on this node. The global
+// limiter has an empty fanout, and fanout limiters have an empty endpoint.
+type limiters struct {
+	mu sync.Mutex
+	m  map[statsKey]*limiter.Limiter
+}
+
+// get returns the limiter with the given max requests, or nil
+// if requests aren't limited. Limiters are replaced when their
+// max requests change.
+func (ls *limiters) get(fanout, endpoint string, max int32) *limiter.Limiter {
+	if max <= 0 {
+		return nil
+	}
+	key := statsKey{fanout: fanout, endpoint: endpoint}
+
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	if l, ok := ls.m[key]; ok && l.Limit() == int(max) {
+		return l
+	}
+	if ls.m == nil {
+		ls.m = make(map[statsKey]*limiter.Limiter)
+	}
+	l := limiter.New(int(max))
+	ls.m[key] = l
+	return l
+}
+
+// doWithLimits makes the request to the endpoint once it is under the
+// endpoint's outbound rate limit and acquires a slot from the endpoint's,
+// the fanout's and the global limits. Slots are held until the endpoint
+// responds.
+func (worker *Worker) doWithLimits(r *http.Request, fanout string, endpoint *pb.Endpoint) (*workerResponse, int, error) {
+	if err := worker.throttle(r, fanout, endpoint); err != nil {
+		return nil, 0, err
+	}
+	release, err := worker.acquire(r, fanout, endpoint)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer release()
+	return worker.doWithRetries(r, fanout, endpoint)
+}
+
+func (worker *Worker) acquire(r *http.Request, fanout string, endpoint *pb.Endpoint) (release func(), err error) {
+	if _, ok := endpoint.Destination.(*pb.Endpoint_FanoutEndpoint); ok {
+		// Fanout endpoints are served in process, their
+		// endpoints acquire their own slots.
+		return func() {}, nil
+	}
+
+	h := worker.handler
+	bestEffort := !endpoint.Primary && endpoint.FailureMode == pb.FailureMode_FAILURE_MODE_BEST_EFFORT
+	limits := []struct {
+		name    string
+		limiter *limiter.Limiter
+		config  *pb.ConcurrencyLimit
+		wait    bool
+	}{
+		// The endpoint's in-flight requests follow the over limit
+		// action of its outbound rate limit.
+		{"in-flight", h.inFlight.get(fanout, endpoint.Name, h.inFlightLimit(endpoint)), nil,
+			overLimitAction(endpoint) == pb.OverLimitAction_OVER_LIMIT_ACTION_WAIT},
+		{"endpoint", h.limiters.get(fanout, endpoint.Name, endpoint.ConcurrencyLimit.GetMaxRequests()), endpoint.ConcurrencyLimit,
+			!bestEffort || endpoint.ConcurrencyLimit.GetQueueBestEffort()},
+		{"fanout", h.limiters.get(fanout, "", worker.config.GetConcurrencyLimit().GetMaxRequests()), worker.config.GetConcurrencyLimit(),
+			!bestEffort || worker.config.GetConcurrencyLimit().GetQueueBestEffort()},
+		{"global", h.limiters.get("", "", int32(h.MaxOutboundRequests)), nil, !bestEffort},
+	}
+
+	priority := limiter.Low
+	if endpoint.Primary {
+		priority = limiter.High
+	}
+
+	var acquired []*limiter.Limiter
+	release = func() {
+		for _, l := range acquired {
+			l.Release()
+		}
+	}
+	for _, limit := range limits {
+		if limit.limiter == nil {
+			continue
+		}
+		var err error
+		if !limit.wait {
+			if !limit.limiter.TryAcquire(priority) {
+				err = errLimited
+			}
+		} else {
+			ctx := r.Context()
+			if ms := limit.config.GetMaxQueueMs(); ms > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, time.Duration(ms)*time.Millisecond)
+				defer cancel()
+			}
+			err = limit.limiter.Acquire(ctx, priority)
+		}
+		if err != nil {
+			release()
+			if limit.name == "in-flight" {
+				worker.overLimit(fanout, endpoint)
+				return nil, &rateLimitError{}
+			}
+			metrics.OutboundLimited.WithLabelValues(fanout, endpoint.Name, limit.name).Inc()
+			return nil, fmt.Errorf("%s %w", limit.name, errLimited)
+		}
+		acquired = append(acquired, limit.limiter)
+	}
+	return release, nil
+}
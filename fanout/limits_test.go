@@ -0,0 +1,190 @@
+package fanout
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pb "github.com/dfanout/dfanout/proto"
+)
+
+func TestAcquire(t *testing.T) {
+	endpoint := func(primary bool, mode pb.FailureMode, limit *pb.ConcurrencyLimit) *pb.Endpoint {
+		return &pb.Endpoint{Name: "e", Primary: primary, FailureMode: mode, ConcurrencyLimit: limit,
+			Destination: &pb.Endpoint_HttpEndpoint{HttpEndpoint: &pb.HTTPEndpoint{Url: "http://127.0.0.1:1"}}}
+	}
+	bestEffort := pb.FailureMode_FAILURE_MODE_BEST_EFFORT
+	required := pb.FailureMode_FAILURE_MODE_REQUIRED
+	tests := []struct {
+		name     string
+		endpoint *pb.Endpoint
+		fanout   *pb.ConcurrencyLimit
+		// wait reports whether the request waits for the
+		// held slot, rather than being shed immediately.
+		wait bool
+	}{
+		{
+			name:     "best effort endpoint limit",
+			endpoint: endpoint(false, bestEffort, &pb.ConcurrencyLimit{MaxRequests: 1}),
+		},
+		{
+			name:     "queued best effort endpoint limit",
+			endpoint: endpoint(false, bestEffort, &pb.ConcurrencyLimit{MaxRequests: 1, QueueBestEffort: true}),
+			wait:     true,
+		},
+		{
+			name:     "required endpoint limit",
+			endpoint: endpoint(false, required, &pb.ConcurrencyLimit{MaxRequests: 1}),
+			wait:     true,
+		},
+		{
+			name:     "primary endpoint limit",
+			endpoint: endpoint(true, bestEffort, &pb.ConcurrencyLimit{MaxRequests: 1}),
+			wait:     true,
+		},
+		{
+			name:     "best effort fanout limit",
+			endpoint: endpoint(false, bestEffort, nil),
+			fanout:   &pb.ConcurrencyLimit{MaxRequests: 1},
+		},
+		{
+			name:     "queued best effort fanout limit",
+			endpoint: endpoint(false, bestEffort, nil),
+			fanout:   &pb.ConcurrencyLimit{MaxRequests: 1, QueueBestEffort: true},
+			wait:     true,
+		},
+		{
+			name:     "required fanout limit",
+			endpoint: endpoint(false, required, nil),
+			fanout:   &pb.ConcurrencyLimit{MaxRequests: 1},
+			wait:     true,
+		},
+	}
+	run := testRuns.Add(1)
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			worker := &Worker{handler: newTestHandler(nil), config: &pb.FanoutConfig{ConcurrencyLimit: tt.fanout}}
+			fanout := fmt.Sprintf("acquire-%d-%d", run, i)
+			r := httptest.NewRequest(http.MethodPost, "/fanout/"+fanout, nil)
+
+			hold, err := worker.acquire(r, fanout, tt.endpoint)
+			if err != nil {
+				t.Fatal(err)
+			}
+			acquired := make(chan error, 1)
+			go func() {
+				release, err := worker.acquire(r, fanout, tt.endpoint)
+				if err == nil {
+					release()
+				}
+				acquired <- err
+			}()
+
+			if !tt.wait {
+				if err := <-acquired; !errors.Is(err, errLimited) {
+					t.Errorf("acquire() = %v, want errLimited", err)
+				}
+				hold()
+				return
+			}
+			select {
+			case err := <-acquired:
+				t.Fatalf("acquire() = %v while the slot is held, want to wait", err)
+			case <-time.After(20 * time.Millisecond):
+			}
+			hold()
+			if err := <-acquired; err != nil {
+				t.Errorf("acquire() after the slot is released = %v", err)
+			}
+		})
+	}
+}
+
+func TestAcquireMaxQueue(t *testing.T) {
+	fanout := fmt.Sprintf("max-queue-%d", testRuns.Add(1))
+	limit := &pb.ConcurrencyLimit{MaxRequests: 1, MaxQueueMs: 10}
+	endpoint := &pb.Endpoint{Name: "e", FailureMode: pb.FailureMode_FAILURE_MODE_REQUIRED, ConcurrencyLimit: limit,
+		Destination: &pb.Endpoint_HttpEndpoint{HttpEndpoint: &pb.HTTPEndpoint{Url: "http://127.0.0.1:1"}}}
+	worker := &Worker{handler: newTestHandler(nil), config: &pb.FanoutConfig{}}
+	r := httptest.NewRequest(http.MethodPost, "/fanout/"+fanout, nil)
+
+	hold, err := worker.acquire(r, fanout, endpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer hold()
+	start := time.Now()
+	if _, err := worker.acquire(r, fanout, endpoint); !errors.Is(err, errLimited) {
+		t.Errorf("acquire() = %v, want errLimited", err)
+	}
+	if d := time.Since(start); d < 10*time.Millisecond {
+		t.Errorf("acquire() failed after %v, want after the max queue time", d)
+	}
+}
+
+func TestAcquirePriority(t *testing.T) {
+	fanout := fmt.Sprintf("priority-%d", testRuns.Add(1))
+	worker := &Worker{
+		handler: newTestHandler(nil),
+		config:  &pb.FanoutConfig{ConcurrencyLimit: &pb.ConcurrencyLimit{MaxRequests: 1}},
+	}
+	endpoint := func(name string, primary bool) *pb.Endpoint {
+		return &pb.Endpoint{Name: name, Primary: primary, FailureMode: pb.FailureMode_FAILURE_MODE_REQUIRED,
+			Destination: &pb.Endpoint_HttpEndpoint{HttpEndpoint: &pb.HTTPEndpoint{Url: "http://127.0.0.1:1"}}}
+	}
+	r := httptest.NewRequest(http.MethodPost, "/fanout/"+fanout, nil)
+	l := worker.handler.limiters.get(fanout, "", 1)
+
+	hold, err := worker.acquire(r, fanout, endpoint("held", false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	order := make(chan string, 2)
+	acquire := func(e *pb.Endpoint) {
+		release, err := worker.acquire(r, fanout, e)
+		if err != nil {
+			t.Error(err)
+			order <- ""
+			return
+		}
+		order <- e.Name
+		release()
+	}
+	// The secondary endpoint starts waiting first.
+	go acquire(endpoint("secondary", false))
+	time.Sleep(20 * time.Millisecond)
+	go acquire(endpoint("primary", true))
+	time.Sleep(20 * time.Millisecond)
+
+	hold()
+	if got := <-order; got != "primary" {
+		t.Errorf("first endpoint to acquire the slot = %q, want primary", got)
+	}
+	if got := <-order; got != "secondary" {
+		t.Errorf("second endpoint to acquire the slot = %q, want secondary", got)
+	}
+	if got := l.InUse(); got != 0 {
+		t.Errorf("InUse() = %d, want 0", got)
+	}
+}
+
+func TestAcquireFanoutEndpoint(t *testing.T) {
+	fanout := fmt.Sprintf("fanout-endpoint-%d", testRuns.Add(1))
+	worker := &Worker{
+		handler: newTestHandler(nil),
+		config:  &pb.FanoutConfig{ConcurrencyLimit: &pb.ConcurrencyLimit{MaxRequests: 1}},
+	}
+	endpoint := &pb.Endpoint{Name: "e", Destination: &pb.Endpoint_FanoutEndpoint{
+		FanoutEndpoint: &pb.FanoutEndpoint{Fanout: "other"},
+	}}
+	r := httptest.NewRequest(http.MethodPost, "/fanout/"+fanout, nil)
+	// Fanout endpoints don't hold slots.
+	for i := 0; i < 2; i++ {
+		if _, err := worker.acquire(r, fanout, endpoint); err != nil {
+			t.Errorf("acquire() %d = %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package fanout
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/dfanout/dfanout/logging"
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/dfanout/dfanout/secrets"
+)
+
+// logEndpoint logs the outcome of a request to an endpoint.
+// Failures are logged as warnings, so they are never sampled out.
+func (worker *Worker) logEndpoint(r *http.Request, endpoint *pb.Endpoint, attempts int, took time.Duration, resp *workerResponse, err error) {
+	method, url := destination(r, endpoint)
+	attrs := []any{
+		logging.Endpoint, endpoint.Name,
+		logging.Primary, endpoint.Primary,
+		logging.Method, method,
+		logging.URL, url,
+		logging.Attempts, attempts,
+		logging.Took(took),
+	}
+	if err != nil {
+		attrs = append(attrs, logging.Error, err)
+		worker.logger.Warn("Endpoint request failed", attrs...)
+		return
+	}
+	attrs = append(attrs, logging.Status, resp.code, logging.Bytes, resp.size)
+	level := slog.LevelInfo
+	if resp.code >= http.StatusBadRequest {
+		level = slog.LevelWarn
+	}
+	if resp.twerr != nil {
+		attrs = append(attrs,
+			"twirp_code", resp.twerr.Code(),
+			"twirp_msg", resp.twerr.Msg(),
+		)
+	}
+	worker.logger.Log(r.Context(), level, "Endpoint responded", attrs...)
+}
+
+// destination returns the method and the URL, or the target for
+// gRPC endpoints, of the endpoint's destination for the request.
+// URL templates are expanded, and passwords are redacted.
+func destination(r *http.Request, endpoint *pb.Endpoint) (method, url string) {
+	switch d := endpoint.Destination.(type) {
+	case *pb.Endpoint_HttpEndpoint:
+		method = r.Method
+		if m := d.HttpEndpoint.Method; m != "" {
+			method = m
+		}
+		url, err := httpURL(r, d.HttpEndpoint)
+		if err != nil {
+			// The request wasn't sent.
+			url = d.HttpEndpoint.Url
+		}
+		return method, secrets.RedactURL(url)
+	case *pb.Endpoint_GrpcEndpoint:
+		return d.GrpcEndpoint.Method, d.GrpcEndpoint.Target
+	case *pb.Endpoint_TwirpEndpoint:
+		return http.MethodPost, secrets.RedactURL(twirpURL(d.TwirpEndpoint))
+	case *pb.Endpoint_FanoutEndpoint:
+		return r.Method, "/fanout/" + d.FanoutEndpoint.Fanout
+	}
+	return r.Method, ""
+}
@@ -0,0 +1,75 @@
+package fanout
+
+import (
+	"net/http"
+	"regexp"
+	"sync"
+
+	pb "github.com/dfanout/dfanout/proto"
+)
+
+// pathRegexps caches the compiled path suffix regexps.
+var pathRegexps sync.Map // string -> *regexp.Regexp
+
+// matches reports whether the request matches
+// any of the endpoint's match rules.
+func matches(r *http.Request, endpoint *pb.Endpoint) bool {
+	if len(endpoint.MatchRules) == 0 || endpoint.Primary {
+		return true
+	}
+	for _, rule := range endpoint.MatchRules {
+		if matchesRule(r, rule) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesRule(r *http.Request, rule *pb.MatchRule) bool {
+	if len(rule.Methods) > 0 && !contains(rule.Methods, r.Method) {
+		return false
+	}
+	for _, h := range rule.Headers {
+		vals := r.Header.Values(h.Name)
+		if len(vals) == 0 || (h.Value != "" && !contains(vals, h.Value)) {
+			return false
+		}
+	}
+	if len(rule.QueryParams) > 0 {
+		query := r.URL.Query()
+		for _, q := range rule.QueryParams {
+			vals, ok := query[q.Name]
+			if !ok || (q.Value != "" && !contains(vals, q.Value)) {
+				return false
+			}
+		}
+	}
+	if rule.PathSuffixRegex != "" {
+		re, err := pathRegexp(rule.PathSuffixRegex)
+		if err != nil || !re.MatchString(r.URL.Path) {
+			return false
+		}
+	}
+	return true
+}
+
+func pathRegexp(expr string) (*regexp.Regexp, error) {
+	if re, ok := pathRegexps.Load(expr); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile("(?:" + expr + ")$")
+	if err != nil {
+		return nil, err
+	}
+	pathRegexps.Store(expr, re)
+	return re, nil
+}
+
+func contains(vals []string, v string) bool {
+	for _, val := range vals {
+		if val == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+// Package merge merges the JSON responses of
+// endpoints into a single JSON document.
+package merge
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ErrorsField is the field of the merged document that reports the
+// endpoints left out of the merge, mapping their names to the reason.
+const ErrorsField = "_errors"
+
+// Conflict is the policy to resolve conflicting fields.
+type Conflict int
+
+const (
+	FirstWins Conflict = iota
+	LastWins
+	Fail
+)
+
+// Document is the JSON response of an endpoint.
+type Document struct {
+	Name string
+	Body []byte
+}
+
+// ConflictError is returned if documents conflict
+// and the conflict policy is Fail.
+type ConflictError struct {
+	Path      string
+	Endpoints [2]string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%q and %q conflict at %q", e.Endpoints[0], e.Endpoints[1], e.Path)
+}
+
+// ByName returns a document that maps each endpoint's name
+// to its document. Documents that are not JSON are left out
+// and added to errs, which is reported in ErrorsField.
+func ByName(docs []Document, errs map[string]string) ([]byte, error) {
+	merged := make(map[string]any, len(docs)+1)
+	for _, d := range docs {
+		v, err := decode(d.Body)
+		if err != nil {
+			errs[d.Name] = err.Error()
+			continue
+		}
+		merged[d.Name] = v
+	}
+	return encode(merged, errs)
+}
+
+// Deep merges the documents field by field, in order. Objects are
+// merged recursively, other values conflict unless they are equal.
+// Documents that are not JSON objects are left out and added to
+// errs, which is reported in ErrorsField.
+func Deep(docs []Document, policy Conflict, errs map[string]string) ([]byte, error) {
+	m := &merger{policy: policy, owners: make(map[string]string)}
+	merged := make(map[string]any)
+	for _, d := range docs {
+		v, err := decode(d.Body)
+		if err != nil {
+			errs[d.Name] = err.Error()
+			continue
+		}
+		obj, ok := v.(map[string]any)
+		if !ok {
+			errs[d.Name] = "response is not a JSON object"
+			continue
+		}
+		if err := m.merge(nil, merged, obj, d.Name); err != nil {
+			return nil, err
+		}
+	}
+	return encode(merged, errs)
+}
+
+type merger struct {
+	policy Conflict
+
+	// owners maps the paths of the merged values
+	// to the endpoints they are taken from.
+	owners map[string]string
+}
+
+func (m *merger) merge(path []string, dst, src map[string]any, name string) error {
+	keys := make([]string, 0, len(src))
+	for k := range src {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		p := append(path[:len(path):len(path)], k)
+		key := strings.Join(p, ".")
+		sv := src[k]
+		dv, ok := dst[k]
+		if !ok {
+			dst[k] = sv
+			m.owners[key] = name
+			continue
+		}
+		dobj, dok := dv.(map[string]any)
+		sobj, sok := sv.(map[string]any)
+		if dok && sok {
+			if err := m.merge(p, dobj, sobj, name); err != nil {
+				return err
+			}
+			continue
+		}
+		if reflect.DeepEqual(dv, sv) {
+			continue
+		}
+		switch m.policy {
+		case LastWins:
+			dst[k] = sv
+			m.owners[key] = name
+		case Fail:
+			return &ConflictError{Path: key, Endpoints: [2]string{m.owner(p), name}}
+		}
+	}
+	return nil
+}
+
+// owner returns the endpoint the value at path, or
+// the object that contains it, was taken from.
+func (m *merger) owner(path []string) string {
+	for i := len(path); i > 0; i-- {
+		if name, ok := m.owners[strings.Join(path[:i], ".")]; ok {
+			return name
+		}
+	}
+	return ""
+}
+
+func decode(b []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, errors.New("response is not JSON")
+	}
+	if dec.More() {
+		return nil, errors.New("response is not JSON")
+	}
+	return v, nil
+}
+
+func encode(merged map[string]any, errs map[string]string) ([]byte, error) {
+	if len(errs) > 0 {
+		merged[ErrorsField] = errs
+	}
+	return json.Marshal(merged)
+}
@@ -0,0 +1,133 @@
+package merge
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDeep(t *testing.T) {
+	tests := []struct {
+		name     string
+		docs     []Document
+		policy   Conflict
+		want     string
+		conflict *ConflictError
+	}{
+		{
+			name: "disjoint",
+			docs: []Document{
+				{Name: "a", Body: []byte(`{"x":1}`)},
+				{Name: "b", Body: []byte(`{"y":2}`)},
+			},
+			want: `{"x":1,"y":2}`,
+		},
+		{
+			name: "nested objects",
+			docs: []Document{
+				{Name: "a", Body: []byte(`{"user":{"id":1,"name":"n"}}`)},
+				{Name: "b", Body: []byte(`{"user":{"id":1,"plan":"pro"}}`)},
+			},
+			policy: Fail,
+			want:   `{"user":{"id":1,"name":"n","plan":"pro"}}`,
+		},
+		{
+			name: "first wins",
+			docs: []Document{
+				{Name: "a", Body: []byte(`{"x":1,"o":{"y":"a"}}`)},
+				{Name: "b", Body: []byte(`{"x":2,"o":{"y":"b"}}`)},
+			},
+			policy: FirstWins,
+			want:   `{"o":{"y":"a"},"x":1}`,
+		},
+		{
+			name: "last wins",
+			docs: []Document{
+				{Name: "a", Body: []byte(`{"x":1,"o":{"y":"a"}}`)},
+				{Name: "b", Body: []byte(`{"x":2,"o":{"y":"b"}}`)},
+			},
+			policy: LastWins,
+			want:   `{"o":{"y":"b"},"x":2}`,
+		},
+		{
+			name: "arrays are not merged",
+			docs: []Document{
+				{Name: "a", Body: []byte(`{"x":[1]}`)},
+				{Name: "b", Body: []byte(`{"x":[2]}`)},
+			},
+			policy: LastWins,
+			want:   `{"x":[2]}`,
+		},
+		{
+			name: "equal values don't conflict",
+			docs: []Document{
+				{Name: "a", Body: []byte(`{"x":[1,{"y":1.0}]}`)},
+				{Name: "b", Body: []byte(`{"x":[1,{"y":1.0}]}`)},
+			},
+			policy: Fail,
+			want:   `{"x":[1,{"y":1.0}]}`,
+		},
+		{
+			name: "conflict",
+			docs: []Document{
+				{Name: "a", Body: []byte(`{"o":{"y":1}}`)},
+				{Name: "b", Body: []byte(`{"o":{"z":2}}`)},
+				{Name: "c", Body: []byte(`{"o":{"y":3}}`)},
+			},
+			policy:   Fail,
+			conflict: &ConflictError{Path: "o.y", Endpoints: [2]string{"a", "c"}},
+		},
+		{
+			name: "conflict with an object",
+			docs: []Document{
+				{Name: "a", Body: []byte(`{"o":{"y":1}}`)},
+				{Name: "b", Body: []byte(`{"o":"s"}`)},
+			},
+			policy:   Fail,
+			conflict: &ConflictError{Path: "o", Endpoints: [2]string{"a", "b"}},
+		},
+		{
+			name: "not objects",
+			docs: []Document{
+				{Name: "a", Body: []byte(`{"x":1}`)},
+				{Name: "b", Body: []byte(`[1]`)},
+				{Name: "c", Body: []byte(`not json`)},
+				{Name: "d", Body: []byte(`{} {}`)},
+			},
+			want: `{"_errors":{"b":"response is not a JSON object","c":"response is not JSON","d":"response is not JSON"},"x":1}`,
+		},
+	}
+	for _, tt := range tests {
+		got, err := Deep(tt.docs, tt.policy, make(map[string]string))
+		if tt.conflict != nil {
+			var ce *ConflictError
+			if !errors.As(err, &ce) || *ce != *tt.conflict {
+				t.Errorf("%s: Deep() error = %v, want %v", tt.name, err, tt.conflict)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: Deep() error = %v", tt.name, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("%s: Deep() = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestByName(t *testing.T) {
+	docs := []Document{
+		{Name: "a", Body: []byte(`{"x":1}`)},
+		{Name: "b", Body: []byte(`[1,2]`)},
+		{Name: "c", Body: []byte(`<html>`)},
+	}
+	errs := map[string]string{"d": "timeout"}
+	got, err := ByName(docs, errs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"_errors":{"c":"response is not JSON","d":"timeout"},"a":{"x":1},"b":[1,2]}`
+	if string(got) != want {
+		t.Errorf("ByName() = %s, want %s", got, want)
+	}
+}
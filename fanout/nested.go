@@ -0,0 +1,85 @@
+package fanout
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	pb "github.com/dfanout/dfanout/proto"
+)
+
+// maxFanoutDepth is the maximum depth of nested fanouts.
+// The admin service rejects cycles in the fanout graph, but
+// cached fanouts can be stale for a while.
+const maxFanoutDepth = 16
+
+type fanoutChainKey struct{}
+
+// fanoutChain returns the fanouts the request has been through
+// when fanouts call other fanouts in process.
+func fanoutChain(ctx context.Context) []string {
+	chain, _ := ctx.Value(fanoutChainKey{}).([]string)
+	return chain
+}
+
+func (worker *Worker) doFanout(r *http.Request, fanout string, endpoint *pb.Endpoint, fanoutEndpoint *pb.FanoutEndpoint) (*workerResponse, error) {
+	target := fanoutEndpoint.Fanout
+
+	chain := fanoutChain(r.Context())
+	if len(chain) == 0 {
+		chain = []string{fanout}
+	}
+	for _, f := range chain {
+		if f == target {
+			return nil, fmt.Errorf("rejected circular call from %q to %q", fanout, target)
+		}
+	}
+	if len(chain) >= maxFanoutDepth {
+		return nil, fmt.Errorf("exceeded the maximum fanout depth of %d", maxFanoutDepth)
+	}
+	// Don't append to chain directly, it's shared by the sibling endpoints.
+	next := append(append([]string{}, chain...), target)
+	ctx := context.WithValue(r.Context(), fanoutChainKey{}, next)
+	// The target fanout buffers the transformed body as its
+	// inbound body, don't apply the transform to its endpoints.
+	ctx = context.WithValue(ctx, transformedBodyKey{}, nil)
+
+	proxyReq := r.Clone(ctx)
+	proxyReq.Body = io.NopCloser(worker.newBodyReader(r))
+	worker.setContentLength(proxyReq, r)
+	proxyReq.Header.Set(circularRequestDetectionHeader, fanout)
+
+	rec := &responseRecorder{header: make(http.Header)}
+	worker.handler.serveFanout(rec, proxyReq, target)
+	return &workerResponse{
+		code:   rec.code,
+		header: rec.header,
+		body:   io.NopCloser(&rec.body),
+		size:   int64(rec.body.Len()),
+	}, nil
+}
+
+// responseRecorder records the response of a fanout
+// called by another fanout.
+type responseRecorder struct {
+	code   int
+	header http.Header
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return r.body.Write(b)
+}
+
+func (r *responseRecorder) WriteHeader(code int) {
+	if r.code == 0 {
+		r.code = code
+	}
+}
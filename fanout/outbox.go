@@ -0,0 +1,136 @@
+package fanout
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/dfanout/dfanout/fanout/outbox"
+	"github.com/dfanout/dfanout/logging"
+	pb "github.com/dfanout/dfanout/proto"
+)
+
+// enqueueTimeout bounds persisting a failed request, which
+// outlives the inbound request if the fan has timed out.
+const enqueueTimeout = 5 * time.Second
+
+// durable reports whether failed requests to the endpoint
+// are persisted to the outbox.
+func (worker *Worker) durable(endpoint *pb.Endpoint) bool {
+	return worker.handler.Outbox != nil &&
+		endpoint.DurableDelivery.GetEnabled() &&
+		!endpoint.Primary &&
+		!worker.hedging()
+}
+
+// enqueue persists the request to the endpoint to the outbox.
+// r is the request after the endpoint's transform is applied.
+func (worker *Worker) enqueue(r *http.Request, fanout string, endpoint *pb.Endpoint, reason string) {
+	m, err := worker.outboxMessage(r, fanout, endpoint, reason)
+	if err == nil {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), enqueueTimeout)
+		defer cancel()
+		err = worker.handler.Outbox.Enqueue(ctx, m)
+	}
+	if err != nil {
+		worker.logger.Error("Cannot persist the request to the outbox", logging.Endpoint, endpoint.Name, logging.Error, err)
+	}
+}
+
+// outboxMessage returns the outbox message of the request to the endpoint.
+func (worker *Worker) outboxMessage(r *http.Request, fanout string, endpoint *pb.Endpoint, reason string) (*outbox.Message, error) {
+	var (
+		proxyReq *http.Request
+		err      error
+	)
+	switch d := endpoint.Destination.(type) {
+	case *pb.Endpoint_HttpEndpoint:
+		proxyReq, err = worker.newHTTPRequest(r, fanout, d.HttpEndpoint)
+	case *pb.Endpoint_TwirpEndpoint:
+		proxyReq, err = worker.newTwirpRequest(r, fanout, d.TwirpEndpoint)
+	default:
+		err = fmt.Errorf("unsupported destination %T", d)
+	}
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(proxyReq.Body)
+	if err != nil {
+		return nil, err
+	}
+	// Secrets aren't persisted, they are resolved again on delivery.
+	removeSecretHeaders(proxyReq.Header, endpointHeaders(endpoint))
+
+	c := endpoint.DurableDelivery
+	return &outbox.Message{
+		Fanout:         fanout,
+		Endpoint:       endpoint.Name,
+		Method:         proxyReq.Method,
+		URL:            proxyReq.URL.String(),
+		Header:         proxyReq.Header,
+		Body:           body,
+		LastError:      reason,
+		MaxAttempts:    int(c.GetMaxAttempts()),
+		InitialBackoff: time.Duration(c.GetInitialBackoffMs()) * time.Millisecond,
+		MaxBackoff:     time.Duration(c.GetMaxBackoffMs()) * time.Millisecond,
+	}, nil
+}
+
+// Deliver sends a request from the outbox to its endpoint, with the
+// endpoint's current client. Requests to endpoints that no longer
+// exist and requests rejected by the endpoint fail permanently.
+func (h *Handler) Deliver(ctx context.Context, m *outbox.Message) error {
+	resp, err := h.FanoutCache.Fanout(ctx, m.Fanout)
+	if err != nil {
+		return fmt.Errorf("cannot retrieve the fanout: %w", err)
+	}
+	var endpoint *pb.Endpoint
+	for _, e := range resp.Endpoints {
+		if e.Name == m.Endpoint {
+			endpoint = e
+		}
+	}
+	if endpoint == nil {
+		return outbox.Permanent(fmt.Errorf("endpoint %q no longer exists in %q", m.Endpoint, m.Fanout))
+	}
+	if h.RateLimiter != nil && endpoint.OutboundRateLimit.GetRequestsPerSecond() > 0 {
+		key, limit := outboundRateLimit(m.Fanout, endpoint)
+		if allowed, _ := h.RateLimiter.Allow(ctx, key, limit); !allowed {
+			return errRateLimited
+		}
+	}
+	client, err := h.ClientCache.HTTPClient(m.Fanout, endpoint)
+	if err != nil {
+		return outbox.Permanent(fmt.Errorf("failed to create a client: %w", err))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, m.Method, m.URL, bytes.NewReader(m.Body))
+	if err != nil {
+		return outbox.Permanent(fmt.Errorf("failed to create a request: %w", err))
+	}
+	req.Header = m.Header.Clone()
+	if req.Header == nil {
+		req.Header = make(http.Header)
+	}
+	if err := h.addSecretHeaders(ctx, req.Header, endpointHeaders(endpoint)); err != nil {
+		return err
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, res.Body)
+
+	switch code := res.StatusCode; {
+	case code < http.StatusBadRequest:
+		return nil
+	case code == http.StatusRequestTimeout || code == http.StatusTooManyRequests || code >= http.StatusInternalServerError:
+		return fmt.Errorf("status %d", code)
+	default:
+		return outbox.Permanent(fmt.Errorf("status %d", code))
+	}
+}
@@ -0,0 +1,392 @@
+// Package outbox persists the requests to durable endpoints that
+// failed, and delivers them in the background until they succeed
+// or they move to the dead letters.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dfanout/dfanout/logging"
+	"github.com/dfanout/dfanout/metrics"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	StatePending = "pending"
+	StateDead    = "dead"
+)
+
+const (
+	DefaultMaxAttempts    = 10
+	DefaultInitialBackoff = 1 * time.Second
+	DefaultMaxBackoff     = 10 * time.Minute
+
+	DefaultPollInterval = 1 * time.Second
+	DefaultBatchSize    = 100
+	DefaultWorkers      = 4
+	DefaultLease        = 1 * time.Minute
+)
+
+// ErrNotFound is returned if a message doesn't exist.
+var ErrNotFound = errors.New("message not found")
+
+// Message is a request to an endpoint in the outbox.
+type Message struct {
+	ID       int64
+	Fanout   string
+	Endpoint string
+	Method   string
+	URL      string
+	Header   http.Header
+	Body     []byte
+
+	State     string
+	Attempts  int
+	LastError string
+
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// DeliverFunc sends a message to its endpoint. Messages are
+// retried if it returns an error, unless the error is permanent.
+type DeliverFunc func(ctx context.Context, m *Message) error
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps an error that won't be fixed by retrying,
+// e.g. the endpoint rejecting the request. Messages that fail
+// with a permanent error move to the dead letters immediately.
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err is a permanent error.
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
+
+type Options struct {
+	// PollInterval is how often the outbox is polled
+	// for messages to deliver.
+	PollInterval time.Duration
+
+	// BatchSize is the max number of messages claimed each poll.
+	BatchSize int
+
+	// Workers is the max number of messages delivered concurrently.
+	Workers int
+
+	// Lease is how long a claimed message is hidden from the other
+	// nodes. Deliveries taking longer than the lease are cancelled.
+	Lease time.Duration
+}
+
+// Outbox is an outbox stored in Postgres. Outboxes of
+// multiple nodes can share the same database.
+type Outbox struct {
+	pool *pgxpool.Pool
+	opts Options
+}
+
+func New(pool *pgxpool.Pool, opts Options) *Outbox {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = DefaultWorkers
+	}
+	if opts.Lease <= 0 {
+		opts.Lease = DefaultLease
+	}
+	return &Outbox{pool: pool, opts: opts}
+}
+
+// Enqueue persists the message, to be delivered after the
+// message's initial backoff.
+func (o *Outbox) Enqueue(ctx context.Context, m *Message) error {
+	if m.MaxAttempts <= 0 {
+		m.MaxAttempts = DefaultMaxAttempts
+	}
+	if m.InitialBackoff <= 0 {
+		m.InitialBackoff = DefaultInitialBackoff
+	}
+	if m.MaxBackoff <= 0 {
+		m.MaxBackoff = DefaultMaxBackoff
+	}
+	header, err := json.Marshal(m.Header)
+	if err != nil {
+		return err
+	}
+	err = o.pool.QueryRow(ctx,
+		`INSERT INTO outbox (fanout_name, endpoint_name, method, url, header, body, state, attempts, max_attempts, initial_backoff_ms, max_backoff_ms, last_error, next_attempt_at, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, 0, $8, $9, $10, $11, NOW() + $12::INTERVAL, NOW(), NOW())
+		 RETURNING id`,
+		m.Fanout, m.Endpoint, m.Method, m.URL, string(header), m.Body, StatePending,
+		m.MaxAttempts, m.InitialBackoff.Milliseconds(), m.MaxBackoff.Milliseconds(), m.LastError, m.InitialBackoff).Scan(&m.ID)
+	if err != nil {
+		return err
+	}
+	m.State = StatePending
+	metrics.OutboxEnqueued.WithLabelValues(m.Fanout, m.Endpoint).Inc()
+	return nil
+}
+
+// Run delivers the pending messages until the context is done.
+func (o *Outbox) Run(ctx context.Context, deliver DeliverFunc) {
+	ticker := time.NewTicker(o.opts.PollInterval)
+	defer ticker.Stop()
+	for {
+		for {
+			n, err := o.poll(ctx, deliver)
+			if err != nil && ctx.Err() == nil {
+				slog.Error("Cannot poll the outbox", logging.Error, err)
+			}
+			// Keep polling while batches are full.
+			if err != nil || n < o.opts.BatchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll claims a batch of pending messages and delivers them.
+func (o *Outbox) poll(ctx context.Context, deliver DeliverFunc) (int, error) {
+	messages, err := o.claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, o.opts.Workers)
+	for _, m := range messages {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(m *Message) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			o.deliver(ctx, deliver, m)
+		}(m)
+	}
+	wg.Wait()
+	return len(messages), nil
+}
+
+// claim returns the pending messages that are due, and hides
+// them from the other nodes for the duration of the lease.
+func (o *Outbox) claim(ctx context.Context) ([]*Message, error) {
+	rows, err := o.pool.Query(ctx,
+		`UPDATE outbox SET next_attempt_at = NOW() + $3::INTERVAL, updated_at = NOW()
+		 WHERE id IN (
+			SELECT id FROM outbox
+			WHERE state = $1 AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED)
+		 RETURNING `+columns, StatePending, o.opts.BatchSize, o.opts.Lease)
+	if err != nil {
+		return nil, err
+	}
+	return collect(rows)
+}
+
+func (o *Outbox) deliver(ctx context.Context, deliver DeliverFunc, m *Message) {
+	logger := slog.Default().With(logging.Fanout, m.Fanout, logging.Endpoint, m.Endpoint, "outbox_id", m.ID)
+
+	dctx, cancel := context.WithTimeout(ctx, o.opts.Lease)
+	err := deliver(dctx, m)
+	cancel()
+	if ctx.Err() != nil {
+		return // shutting down, the message is delivered after the lease
+	}
+
+	m.Attempts++
+	result := outcome(m, err)
+	switch result {
+	case delivered:
+		_, err = o.pool.Exec(ctx, `DELETE FROM outbox WHERE id = $1`, m.ID)
+		logger.Info("Delivered the outbox message", logging.Attempts, m.Attempts)
+	case dead:
+		logger.Warn("Moved the outbox message to the dead letters", logging.Attempts, m.Attempts, logging.Error, err)
+		_, err = o.pool.Exec(ctx,
+			`UPDATE outbox SET state = $1, attempts = $2, last_error = $3, updated_at = NOW()
+			 WHERE id = $4`, StateDead, m.Attempts, err.Error(), m.ID)
+	case retried:
+		logger.Info("Cannot deliver the outbox message", logging.Attempts, m.Attempts, logging.Error, err)
+		_, err = o.pool.Exec(ctx,
+			`UPDATE outbox SET attempts = $1, last_error = $2, next_attempt_at = NOW() + $3::INTERVAL, updated_at = NOW()
+			 WHERE id = $4`, m.Attempts, err.Error(), backoff(m), m.ID)
+	}
+	metrics.OutboxDeliveries.WithLabelValues(m.Fanout, m.Endpoint, result).Inc()
+	if err != nil {
+		logger.Error("Cannot update the outbox message", logging.Error, err)
+	}
+}
+
+// Outcomes of a delivery attempt.
+const (
+	delivered = "delivered"
+	dead      = "dead"
+	retried   = "retried"
+)
+
+// outcome returns the outcome of a delivery attempt of the message
+// that returned err. m.Attempts includes the attempt.
+func outcome(m *Message, err error) string {
+	switch {
+	case err == nil:
+		return delivered
+	case IsPermanent(err) || m.Attempts >= m.MaxAttempts:
+		return dead
+	default:
+		return retried
+	}
+}
+
+// backoff returns the backoff before the next delivery attempt,
+// doubling after each attempt with up to 50% jitter.
+func backoff(m *Message) time.Duration {
+	d := m.InitialBackoff
+	for i := 1; i < m.Attempts && d < m.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > m.MaxBackoff {
+		d = m.MaxBackoff
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// List returns up to limit dead letters of the fanout with IDs greater
+// than afterID, ordered by ID. If endpoint is empty, the dead letters
+// of all endpoints are returned. Bodies are not returned.
+func (o *Outbox) List(ctx context.Context, fanout, endpoint string, afterID int64, limit int) ([]*Message, error) {
+	rows, err := o.pool.Query(ctx,
+		`SELECT `+columns+` FROM outbox
+		 WHERE state = $1 AND fanout_name = $2 AND ($3 = '' OR endpoint_name = $3) AND id > $4
+		 ORDER BY id
+		 LIMIT $5`, StateDead, fanout, endpoint, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := collect(rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range messages {
+		m.Header, m.Body = nil, nil
+	}
+	return messages, nil
+}
+
+// Get returns the message with the given ID.
+func (o *Outbox) Get(ctx context.Context, id int64) (*Message, error) {
+	rows, err := o.pool.Query(ctx,
+		`SELECT `+columns+` FROM outbox WHERE id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := collect(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, ErrNotFound
+	}
+	return messages[0], nil
+}
+
+// Replay queues the dead letters of the fanout to be delivered again
+// with a fresh number of attempts. If endpoint is empty, the dead
+// letters of all endpoints are replayed. If ids is empty, all dead
+// letters of the endpoints are replayed. It returns the number of
+// replayed dead letters.
+func (o *Outbox) Replay(ctx context.Context, fanout, endpoint string, ids []int64) (int64, error) {
+	if ids == nil {
+		ids = []int64{}
+	}
+	tag, err := o.pool.Exec(ctx,
+		`UPDATE outbox SET state = $1, attempts = 0, next_attempt_at = NOW(), updated_at = NOW()
+		 WHERE state = $2 AND fanout_name = $3 AND ($4 = '' OR endpoint_name = $4) AND (cardinality($5::BIGINT[]) = 0 OR id = ANY($5))`,
+		StatePending, StateDead, fanout, endpoint, ids)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// Purge deletes the dead letters of the fanout. If endpoint is empty,
+// the dead letters of all endpoints are deleted. If ids is empty, all
+// dead letters of the endpoints are deleted. It returns the number of
+// deleted dead letters.
+func (o *Outbox) Purge(ctx context.Context, fanout, endpoint string, ids []int64) (int64, error) {
+	if ids == nil {
+		ids = []int64{}
+	}
+	tag, err := o.pool.Exec(ctx,
+		`DELETE FROM outbox
+		 WHERE state = $1 AND fanout_name = $2 AND ($3 = '' OR endpoint_name = $3) AND (cardinality($4::BIGINT[]) = 0 OR id = ANY($4))`,
+		StateDead, fanout, endpoint, ids)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+const columns = `id, fanout_name, endpoint_name, method, url, header, body, state, attempts, max_attempts, initial_backoff_ms, max_backoff_ms, last_error, created_at, updated_at`
+
+func collect(rows pgx.Rows) ([]*Message, error) {
+	defer rows.Close()
+
+	var messages []*Message
+	for rows.Next() {
+		var (
+			m              Message
+			header         *string
+			lastError      *string
+			initialBackoff int64
+			maxBackoff     int64
+		)
+		err := rows.Scan(&m.ID, &m.Fanout, &m.Endpoint, &m.Method, &m.URL, &header, &m.Body, &m.State,
+			&m.Attempts, &m.MaxAttempts, &initialBackoff, &maxBackoff, &lastError, &m.CreatedAt, &m.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if header != nil {
+			if err := json.Unmarshal([]byte(*header), &m.Header); err != nil {
+				return nil, err
+			}
+		}
+		if lastError != nil {
+			m.LastError = *lastError
+		}
+		m.InitialBackoff = time.Duration(initialBackoff) * time.Millisecond
+		m.MaxBackoff = time.Duration(maxBackoff) * time.Millisecond
+		messages = append(messages, &m)
+	}
+	return messages, rows.Err()
+}
@@ -0,0 +1,70 @@
+package outbox
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestOutcome(t *testing.T) {
+	errStatus := errors.New("status 503")
+	tests := []struct {
+		name     string
+		attempts int
+		err      error
+		want     string
+	}{
+		{name: "delivered", attempts: 1, want: delivered},
+		{name: "delivered on the last attempt", attempts: 3, want: delivered},
+		{name: "retried", attempts: 1, err: errStatus, want: retried},
+		{name: "retried before the last attempt", attempts: 2, err: errStatus, want: retried},
+		{name: "dead after the last attempt", attempts: 3, err: errStatus, want: dead},
+		{name: "dead after more attempts", attempts: 4, err: errStatus, want: dead},
+		{name: "permanent", attempts: 1, err: Permanent(errStatus), want: dead},
+		{name: "wrapped permanent", attempts: 1, err: fmt.Errorf("delivering: %w", Permanent(errStatus)), want: dead},
+	}
+	for _, tt := range tests {
+		m := &Message{Attempts: tt.attempts, MaxAttempts: 3}
+		if got := outcome(m, tt.err); got != tt.want {
+			t.Errorf("%s: outcome() = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPermanent(t *testing.T) {
+	err := errors.New("status 400")
+	if IsPermanent(err) {
+		t.Error("IsPermanent() = true, want false")
+	}
+	if !IsPermanent(Permanent(err)) {
+		t.Error("IsPermanent(Permanent()) = false, want true")
+	}
+	if !errors.Is(Permanent(err), err) {
+		t.Error("Permanent() doesn't wrap the error")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration // before jitter
+	}{
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 3, want: 4 * time.Second},
+		{attempts: 4, want: 8 * time.Second},
+		{attempts: 5, want: 10 * time.Second},
+		{attempts: 100, want: 10 * time.Second},
+	}
+	for _, tt := range tests {
+		m := &Message{Attempts: tt.attempts, InitialBackoff: time.Second, MaxBackoff: 10 * time.Second}
+		for i := 0; i < 100; i++ {
+			// Up to 50% jitter.
+			if got := backoff(m); got < tt.want/2 || got > tt.want {
+				t.Errorf("backoff() after %d attempts = %v, want in [%v, %v]", tt.attempts, got, tt.want/2, tt.want)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package fanout
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dfanout/dfanout/fanout/outbox"
+	"github.com/dfanout/dfanout/fanout/tee"
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/dfanout/dfanout/secrets"
+)
+
+func TestOutboxMessage(t *testing.T) {
+	t.Setenv("DFANOUT_SECRET_TOKEN", "s3cret")
+	store, err := secrets.New(nil, nil, secrets.Policy{EnvPrefix: secrets.DefaultEnvPrefix})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := newTestHandler(nil)
+	h.Secrets = store
+
+	body, err := tee.New(io.NopCloser(strings.NewReader(`{"id":1}`)), tee.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+	worker := &Worker{handler: h, body: body}
+
+	endpoint := &pb.Endpoint{
+		Name: "e",
+		Destination: &pb.Endpoint_HttpEndpoint{HttpEndpoint: &pb.HTTPEndpoint{
+			Url:    "https://example.com/events",
+			Method: http.MethodPut,
+			Header: []*pb.Header{
+				{Key: "X-Source", Values: []string{"dfanout"}},
+				{Key: "Authorization", ValueRef: "env:DFANOUT_SECRET_TOKEN"},
+			},
+		}},
+		DurableDelivery: &pb.DurableDelivery{Enabled: true, MaxAttempts: 5, InitialBackoffMs: 100, MaxBackoffMs: 2000},
+	}
+	r := httptest.NewRequest(http.MethodPost, "/fanout/f", nil)
+	r.Header.Set("X-Request-Id", "1")
+	m, err := worker.outboxMessage(r, "f", endpoint, "status 503")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The secret header is resolved again on delivery.
+	if v := m.Header.Values("Authorization"); v != nil {
+		t.Errorf("message has the secret header Authorization: %q", v)
+	}
+	for key, want := range map[string]string{"X-Source": "dfanout", "X-Request-Id": "1", circularRequestDetectionHeader: "f"} {
+		if got := m.Header.Get(key); got != want {
+			t.Errorf("message header %s = %q, want %q", key, got, want)
+		}
+	}
+	want := &outbox.Message{
+		Fanout:         "f",
+		Endpoint:       "e",
+		Method:         http.MethodPut,
+		URL:            "https://example.com/events",
+		Header:         m.Header,
+		Body:           []byte(`{"id":1}`),
+		LastError:      "status 503",
+		MaxAttempts:    5,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("outboxMessage() = %+v, want %+v", m, want)
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	t.Setenv("DFANOUT_SECRET_TOKEN", "s3cret")
+	store, err := secrets.New(nil, nil, secrets.Policy{EnvPrefix: secrets.DefaultEnvPrefix})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The server responds with the status in the path.
+	var (
+		mu            sync.Mutex
+		authorization []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		authorization = r.Header.Values("Authorization")
+		mu.Unlock()
+		code, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/"))
+		if err != nil {
+			code = http.StatusBadRequest
+		}
+		w.WriteHeader(code)
+	}))
+	defer srv.Close()
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	fanout := fmt.Sprintf("deliver-%d", testRuns.Add(1))
+	h := newTestHandler(map[string]*pb.GetFanoutResponse{
+		fanout: {Endpoints: []*pb.Endpoint{{
+			Name: "e",
+			Destination: &pb.Endpoint_HttpEndpoint{HttpEndpoint: &pb.HTTPEndpoint{
+				Url:    srv.URL,
+				Header: []*pb.Header{{Key: "Authorization", ValueRef: "env:DFANOUT_SECRET_TOKEN"}},
+			}},
+		}}},
+	})
+	h.Secrets = store
+
+	tests := []struct {
+		name      string
+		endpoint  string
+		url       string
+		ok        bool
+		permanent bool
+	}{
+		{name: "200", url: srv.URL + "/200", ok: true},
+		{name: "204", url: srv.URL + "/204", ok: true},
+		{name: "400", url: srv.URL + "/400", permanent: true},
+		{name: "404", url: srv.URL + "/404", permanent: true},
+		{name: "408", url: srv.URL + "/408"},
+		{name: "429", url: srv.URL + "/429"},
+		{name: "500", url: srv.URL + "/500"},
+		{name: "503", url: srv.URL + "/503"},
+		{name: "connection error", url: closed.URL + "/200"},
+		{name: "endpoint removed", endpoint: "removed", url: srv.URL + "/200", permanent: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint := "e"
+			if tt.endpoint != "" {
+				endpoint = tt.endpoint
+			}
+			m := &outbox.Message{
+				Fanout:   fanout,
+				Endpoint: endpoint,
+				Method:   http.MethodPost,
+				URL:      tt.url,
+				Header:   http.Header{"X-Request-Id": {"1"}},
+				Body:     []byte(`{}`),
+			}
+			err := h.Deliver(context.Background(), m)
+			if tt.ok {
+				if err != nil {
+					t.Errorf("Deliver() = %v, want nil", err)
+				}
+			} else if err == nil || outbox.IsPermanent(err) != tt.permanent {
+				t.Errorf("Deliver() = %v, want permanent = %v", err, tt.permanent)
+			}
+		})
+	}
+
+	// The secret header is resolved on delivery.
+	mu.Lock()
+	defer mu.Unlock()
+	if want := []string{"s3cret"}; !reflect.DeepEqual(authorization, want) {
+		t.Errorf("endpoint received Authorization %q, want %q", authorization, want)
+	}
+}
@@ -0,0 +1,119 @@
+package fanout
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/dfanout/dfanout/debug"
+	"github.com/dfanout/dfanout/fanout/tee"
+	"github.com/dfanout/dfanout/logging"
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/dfanout/dfanout/secrets"
+)
+
+// maxPreviewBody is the maximum number of body bytes previewed.
+const maxPreviewBody = 64 << 10 // 64 KB
+
+// servePreview serves the debug page with the requests that would
+// be sent to the endpoints for r, without sending them.
+func (h *Handler) servePreview(w http.ResponseWriter, r *http.Request, fanout string, resp *pb.GetFanoutResponse) {
+	// Don't forward the debug param to the endpoints.
+	r = r.Clone(r.Context())
+	query := r.URL.Query()
+	query.Del("debug")
+	r.URL.RawQuery = query.Encode()
+
+	body, err := tee.New(r.Body, h.bodyOptions(resp.Config.GetBody()))
+	if err != nil {
+		if body != nil {
+			body.Close()
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "cannot preview the request body: %v", err)
+		return
+	}
+	defer body.Close()
+	authReq := h.authenticate(w, r, fanout, resp.Config.GetInboundAuth(), body)
+	if authReq == nil {
+		return
+	}
+	r = authReq
+
+	worker := &Worker{
+		fanout:      fanout,
+		config:      resp.Config,
+		endpoints:   resp.Endpoints,
+		handler:     h,
+		clientCache: h.ClientCache,
+		logger:      logging.FromContext(r.Context()).With(logging.Fanout, fanout),
+		body:        body,
+	}
+	previews := make(map[string]*debug.Preview, len(resp.Endpoints))
+	for _, e := range resp.Endpoints {
+		previews[e.Name] = worker.preview(r, fanout, e)
+	}
+	debug.NewPreviewHandler(fanout, resp.Endpoints, h.debugStatus(fanout, resp.Endpoints), previews).ServeHTTP(w, r)
+}
+
+func (worker *Worker) preview(r *http.Request, fanout string, endpoint *pb.Endpoint) *debug.Preview {
+	switch {
+	case !matches(r, endpoint):
+		return &debug.Preview{Skipped: "the request doesn't match the rules"}
+	case !worker.sampled(r, endpoint):
+		return &debug.Preview{Skipped: "the request is sampled out"}
+	}
+
+	r, err := worker.applyTransform(worker.callerHeaders(r, endpoint), endpoint)
+	if err != nil {
+		return &debug.Preview{Error: err.Error()}
+	}
+	var proxyReq *http.Request
+	switch d := endpoint.Destination.(type) {
+	case *pb.Endpoint_HttpEndpoint:
+		proxyReq, err = worker.newHTTPRequest(r, fanout, d.HttpEndpoint)
+	case *pb.Endpoint_TwirpEndpoint:
+		proxyReq, err = worker.newTwirpRequest(r, fanout, d.TwirpEndpoint)
+	default:
+		// gRPC and fanout endpoints are sent the inbound
+		// request's body and headers.
+		method, url := destination(r, endpoint)
+		proxyReq = r.Clone(r.Context())
+		proxyReq.Method = method
+		proxyReq.Header = make(http.Header)
+		proxyReq.Body = io.NopCloser(worker.newBodyReader(r))
+		setHeaders(proxyReq, r, fanout, nil)
+		return previewRequest(proxyReq, url, endpointHeaders(endpoint))
+	}
+	if err != nil {
+		return &debug.Preview{Error: err.Error()}
+	}
+	return previewRequest(proxyReq, proxyReq.URL.String(), endpointHeaders(endpoint))
+}
+
+// previewRequest describes the request, with the values of the
+// endpoint's secret headers and other credentials redacted.
+func previewRequest(r *http.Request, url string, headers []*pb.Header) *debug.Preview {
+	var body strings.Builder
+	if r.Body != nil {
+		n, _ := io.Copy(&body, io.LimitReader(r.Body, maxPreviewBody))
+		if n == maxPreviewBody {
+			body.WriteString("\n... (truncated)")
+		}
+	}
+	// The inbound Content-Length is replaced when the request is sent.
+	header := r.Header.Clone()
+	header.Del("Content-Length")
+	if r.ContentLength > 0 {
+		header.Set("Content-Length", strconv.FormatInt(r.ContentLength, 10))
+	}
+	redactHeaders(header, headers)
+	return &debug.Preview{
+		Method: r.Method,
+		URL:    secrets.RedactURL(url),
+		Header: header,
+		Body:   body.String(),
+	}
+}
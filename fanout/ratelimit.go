@@ -0,0 +1,224 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dfanout/dfanout/logging"
+	"github.com/dfanout/dfanout/metrics"
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/dfanout/dfanout/ratelimit"
+)
+
+// rateLimited reports whether the request is over the fanout's rate
+// limit. Rejected requests are responded with 429 and Retry-After.
+func (h *Handler) rateLimited(w http.ResponseWriter, r *http.Request, fanout string, c *pb.RateLimit) bool {
+	if h.RateLimiter == nil || c.GetRequestsPerSecond() <= 0 {
+		return false
+	}
+	allowed, retryAfter := h.RateLimiter.Allow(r.Context(), rateLimitKey(r, fanout, c), inboundRateLimit(c))
+	if allowed {
+		return false
+	}
+
+	metrics.InboundRateLimited.WithLabelValues(fanout).Inc()
+	logging.FromContext(r.Context()).Info("Rate limited the request", logging.Fanout, fanout)
+	seconds := int(math.Max(1, math.Ceil(retryAfter.Seconds())))
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	w.WriteHeader(http.StatusTooManyRequests)
+	fmt.Fprintln(w, "rate limit exceeded")
+	return true
+}
+
+func inboundRateLimit(c *pb.RateLimit) ratelimit.Limit {
+	return ratelimit.Limit{
+		Rate:  c.GetRequestsPerSecond(),
+		Burst: int(c.GetBurst()),
+	}
+}
+
+// RateLimit returns the limit of a key of the inbound or the outbound
+// rate limits, from the config of the fanout, for the rate limiter to
+// answer its peers.
+func (c *Cache) RateLimit(ctx context.Context, key string) (ratelimit.Limit, error) {
+	// Outbound keys start with a zero byte, see outboundRateLimit.
+	outbound := strings.HasPrefix(key, "\x00")
+	fanout, endpoint, _ := strings.Cut(strings.TrimPrefix(key, "\x00"), "\x00")
+	resp, err := c.Fanout(ctx, fanout)
+	if err != nil {
+		return ratelimit.Limit{}, err
+	}
+	if !outbound {
+		if resp.Config.GetRateLimit().GetRequestsPerSecond() <= 0 {
+			return ratelimit.Limit{}, fmt.Errorf("fanout %q has no rate limit", fanout)
+		}
+		return inboundRateLimit(resp.Config.GetRateLimit()), nil
+	}
+	for _, e := range resp.Endpoints {
+		if e.Name == endpoint && e.OutboundRateLimit.GetRequestsPerSecond() > 0 {
+			_, limit := outboundRateLimit(fanout, e)
+			return limit, nil
+		}
+	}
+	return ratelimit.Limit{}, fmt.Errorf("endpoint %q of %q has no outbound rate limit", endpoint, fanout)
+}
+
+// rateLimitKey returns the key of the request's rate limit,
+// the fanout and the client if clients are limited separately.
+func rateLimitKey(r *http.Request, fanout string, c *pb.RateLimit) string {
+	var client string
+	switch k := c.Key.(type) {
+	case *pb.RateLimit_Header:
+		client = r.Header.Get(k.Header)
+	case *pb.RateLimit_ClientIp:
+		if k.ClientIp {
+			client = clientIP(r)
+		}
+	default:
+		return fanout
+	}
+	return fanout + "\x00" + client
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+var errRateLimited = errors.New("outbound rate limit reached")
+
+// rateLimitError is the error of a request over its endpoint's
+// outbound rate limit.
+type rateLimitError struct {
+	retryAfter time.Duration
+}
+
+func (e *rateLimitError) Error() string { return errRateLimited.Error() }
+
+func (e *rateLimitError) Is(target error) bool { return target == errRateLimited }
+
+// overLimitAction returns the endpoint's over limit action,
+// resolving the default for primary and other endpoints.
+func overLimitAction(endpoint *pb.Endpoint) pb.OverLimitAction {
+	if a := endpoint.OutboundRateLimit.GetAction(); a != pb.OverLimitAction_OVER_LIMIT_ACTION_DEFAULT {
+		return a
+	}
+	if endpoint.Primary {
+		return pb.OverLimitAction_OVER_LIMIT_ACTION_FAIL
+	}
+	return pb.OverLimitAction_OVER_LIMIT_ACTION_DROP
+}
+
+// throttle takes a token from the endpoint's outbound rate limit,
+// shared by the cluster. Requests over the limit either wait for a
+// token or fail with a rateLimitError, depending on the endpoint's
+// over limit action.
+func (worker *Worker) throttle(r *http.Request, fanout string, endpoint *pb.Endpoint) error {
+	h := worker.handler
+	c := endpoint.OutboundRateLimit
+	if h.RateLimiter == nil || c.GetRequestsPerSecond() <= 0 {
+		return nil
+	}
+	key, limit := outboundRateLimit(fanout, endpoint)
+	action := overLimitAction(endpoint)
+	for waited := false; ; waited = true {
+		allowed, retryAfter := h.RateLimiter.Allow(r.Context(), key, limit)
+		if allowed {
+			if waited {
+				metrics.OutboundRateLimited.WithLabelValues(fanout, endpoint.Name, "waited").Inc()
+			}
+			return nil
+		}
+		if action != pb.OverLimitAction_OVER_LIMIT_ACTION_WAIT {
+			worker.overLimit(fanout, endpoint)
+			return &rateLimitError{retryAfter: retryAfter}
+		}
+		t := time.NewTimer(retryAfter)
+		select {
+		case <-r.Context().Done():
+			t.Stop()
+			worker.overLimit(fanout, endpoint)
+			return &rateLimitError{retryAfter: retryAfter}
+		case <-t.C:
+		}
+	}
+}
+
+// outboundRateLimit returns the key and the limit of the
+// endpoint's outbound rate limit.
+func outboundRateLimit(fanout string, endpoint *pb.Endpoint) (string, ratelimit.Limit) {
+	c := endpoint.OutboundRateLimit
+	// Inbound keys start with the fanout name, which is never empty.
+	return "\x00" + fanout + "\x00" + endpoint.Name, ratelimit.Limit{
+		Rate:  c.GetRequestsPerSecond(),
+		Burst: int(c.GetBurst()),
+	}
+}
+
+// inFlightLimit returns this node's share of the endpoint's
+// max in-flight requests.
+func (h *Handler) inFlightLimit(endpoint *pb.Endpoint) int32 {
+	max := endpoint.OutboundRateLimit.GetMaxInFlight()
+	if max <= 0 {
+		return 0
+	}
+	nodes := 1
+	if h.RateLimiter != nil {
+		nodes = h.RateLimiter.Peers()
+	}
+	return int32(math.Max(1, math.Ceil(float64(max)/float64(nodes))))
+}
+
+// overLimit counts a request that is not sent because it is over the
+// endpoint's outbound rate limit.
+func (worker *Worker) overLimit(fanout string, endpoint *pb.Endpoint) {
+	action := "dropped"
+	switch {
+	case worker.deferrable(endpoint):
+		action = "deferred"
+	case overLimitAction(endpoint) != pb.OverLimitAction_OVER_LIMIT_ACTION_DROP:
+		action = "failed"
+	}
+	metrics.OutboundRateLimited.WithLabelValues(fanout, endpoint.Name, action).Inc()
+}
+
+// deferrable reports whether requests over the endpoint's outbound
+// rate limit are persisted to the outbox.
+func (worker *Worker) deferrable(endpoint *pb.Endpoint) bool {
+	return worker.handler.Outbox != nil &&
+		overLimitAction(endpoint) == pb.OverLimitAction_OVER_LIMIT_ACTION_DEFER &&
+		!endpoint.Primary &&
+		!worker.hedging()
+}
+
+// writeRateLimited responds if the primary endpoint failed the fan
+// because of its outbound rate limit; with 503 if the primary drops
+// the requests over the limit, or with 429 and Retry-After.
+func (worker *Worker) writeRateLimited(w http.ResponseWriter, results []endpointResult) bool {
+	for i, e := range worker.endpoints {
+		var rle *rateLimitError
+		if !e.Primary || !errors.As(results[i].err, &rle) {
+			continue
+		}
+		if overLimitAction(e) == pb.OverLimitAction_OVER_LIMIT_ACTION_DROP {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return true
+		}
+		seconds := int(math.Max(1, math.Ceil(rle.retryAfter.Seconds())))
+		w.Header().Set("Retry-After", strconv.Itoa(seconds))
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprintln(w, "endpoint rate limit exceeded")
+		return true
+	}
+	return false
+}
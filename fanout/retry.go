@@ -0,0 +1,225 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/dfanout/dfanout/logging"
+	"github.com/dfanout/dfanout/metrics"
+	pb "github.com/dfanout/dfanout/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultInitialBackoff    = 100 * time.Millisecond
+	defaultMaxBackoff        = 5 * time.Second
+	defaultBackoffMultiplier = 2
+)
+
+// doWithRetries makes a request to the endpoint and retries it
+// according to the endpoint's retry policy. It returns the
+// number of attempts made along with the last response or error.
+func (worker *Worker) doWithRetries(r *http.Request, fanout string, endpoint *pb.Endpoint) (resp *workerResponse, attempts int, err error) {
+	policy := endpoint.RetryPolicy
+	maxAttempts := 1
+	if worker.canRetry(r, endpoint) {
+		maxAttempts = int(policy.MaxAttempts)
+	}
+
+	backoff := defaultInitialBackoff
+	if ms := policy.GetInitialBackoffMs(); ms > 0 {
+		backoff = time.Duration(ms) * time.Millisecond
+	}
+	maxBackoff := defaultMaxBackoff
+	if ms := policy.GetMaxBackoffMs(); ms > 0 {
+		maxBackoff = time.Duration(ms) * time.Millisecond
+	}
+	multiplier := float64(defaultBackoffMultiplier)
+	if m := policy.GetBackoffMultiplier(); m >= 1 {
+		multiplier = m
+	}
+
+	for attempts = 1; ; attempts++ {
+		resp, err = worker.attempt(r, fanout, endpoint)
+		if attempts >= maxAttempts || !shouldRetry(policy, resp, err) {
+			return resp, attempts, err
+		}
+
+		delay := jitter(backoff, policy.Jitter)
+		if deadline, ok := r.Context().Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			worker.logger.Debug("Not retrying past the deadline",
+				logging.Endpoint, endpoint.Name,
+				logging.Attempts, attempts,
+			)
+			return resp, attempts, err
+		}
+		worker.logger.Debug("Retrying",
+			logging.Endpoint, endpoint.Name,
+			logging.Attempts, attempts,
+			logging.Outcome, attemptOutcome(resp, err),
+			"delay_ms", delay.Milliseconds(),
+		)
+		if resp != nil {
+			resp.Close() // discard the failed response
+		}
+
+		t := time.NewTimer(delay)
+		select {
+		case <-t.C:
+		case <-r.Context().Done():
+			t.Stop()
+			return nil, attempts, r.Context().Err()
+		}
+		backoff = time.Duration(float64(backoff) * multiplier)
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// attempt makes a single request to the endpoint.
+func (worker *Worker) attempt(r *http.Request, fanout string, endpoint *pb.Endpoint) (*workerResponse, error) {
+	inFlight := metrics.OutboundInFlight.WithLabelValues(fanout, endpoint.Name)
+	inFlight.Inc()
+	defer inFlight.Dec()
+
+	start := time.Now()
+	resp, err := worker.sendWithTimeout(r, fanout, endpoint)
+	metrics.OutboundLatency.WithLabelValues(fanout, endpoint.Name).Observe(time.Since(start).Seconds())
+
+	class := "error"
+	if err == nil {
+		class = metrics.StatusClass(resp.code)
+	}
+	metrics.OutboundAttempts.WithLabelValues(fanout, endpoint.Name, class).Inc()
+	return resp, err
+}
+
+// sendWithTimeout makes a request to the endpoint
+// with the retry policy's per attempt timeout.
+func (worker *Worker) sendWithTimeout(r *http.Request, fanout string, endpoint *pb.Endpoint) (*workerResponse, error) {
+	ms := endpoint.RetryPolicy.GetPerAttemptTimeoutMs()
+	if ms <= 0 {
+		return worker.send(r, fanout, endpoint)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), time.Duration(ms)*time.Millisecond)
+	resp, err := worker.send(r.WithContext(ctx), fanout, endpoint)
+	if err != nil || resp.body == nil {
+		cancel()
+		return resp, err
+	}
+	// The attempt is not done until the response body is read.
+	resp.body = &cancelOnClose{ReadCloser: resp.body, cancel: cancel}
+	return resp, nil
+}
+
+// canRetry reports whether requests to the endpoint can be retried.
+func (worker *Worker) canRetry(r *http.Request, endpoint *pb.Endpoint) bool {
+	policy := endpoint.RetryPolicy
+	if policy.GetMaxAttempts() <= 1 {
+		return false
+	}
+	if worker.primaryOnly {
+		// The body is streamed and can't be replayed.
+		return false
+	}
+	if policy.RetryNonIdempotent {
+		return true
+	}
+	return idempotent(r, endpoint)
+}
+
+// idempotent reports whether the request to the endpoint is idempotent,
+// and can be sent more than once.
+func idempotent(r *http.Request, endpoint *pb.Endpoint) bool {
+	switch d := endpoint.Destination.(type) {
+	case *pb.Endpoint_HttpEndpoint:
+		method := r.Method
+		if m := d.HttpEndpoint.Method; m != "" {
+			method = m
+		}
+		return isIdempotent(method)
+	case *pb.Endpoint_FanoutEndpoint:
+		return isIdempotent(r.Method)
+	default:
+		// gRPC and Twirp don't have idempotency semantics.
+		return false
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func shouldRetry(policy *pb.RetryPolicy, resp *workerResponse, err error) bool {
+	if err != nil {
+		return policy.RetryNetworkErrors && isNetworkError(err)
+	}
+	for _, code := range policy.RetryableStatusCodes {
+		if int(code) == resp.code {
+			return true
+		}
+	}
+	return false
+}
+
+// isNetworkError reports whether err is a failure to connect
+// to the endpoint, a broken connection or a timeout.
+func isNetworkError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	if st, ok := status.FromError(err); ok {
+		return st.Code() == codes.Unavailable || st.Code() == codes.DeadlineExceeded
+	}
+	return false
+}
+
+// jitter randomizes d by the given fraction of d.
+func jitter(d time.Duration, fraction float64) time.Duration {
+	if fraction <= 0 {
+		return d
+	}
+	delta := fraction * float64(d)
+	return time.Duration(float64(d) - delta + rand.Float64()*2*delta)
+}
+
+func attemptOutcome(resp *workerResponse, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return fmt.Sprintf("status %d", resp.code)
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
@@ -0,0 +1,251 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCanRetry(t *testing.T) {
+	httpEndpoint := func(method string, policy *pb.RetryPolicy) *pb.Endpoint {
+		return &pb.Endpoint{Name: "e", RetryPolicy: policy, Destination: &pb.Endpoint_HttpEndpoint{
+			HttpEndpoint: &pb.HTTPEndpoint{Url: "http://127.0.0.1:1", Method: method},
+		}}
+	}
+	retries := &pb.RetryPolicy{MaxAttempts: 3}
+	tests := []struct {
+		name        string
+		method      string
+		endpoint    *pb.Endpoint
+		primaryOnly bool
+		want        bool
+	}{
+		{name: "no policy", method: http.MethodGet, endpoint: httpEndpoint("", nil)},
+		{name: "single attempt", method: http.MethodGet, endpoint: httpEndpoint("", &pb.RetryPolicy{MaxAttempts: 1})},
+		{name: "GET", method: http.MethodGet, endpoint: httpEndpoint("", retries), want: true},
+		{name: "PUT", method: http.MethodPut, endpoint: httpEndpoint("", retries), want: true},
+		{name: "DELETE", method: http.MethodDelete, endpoint: httpEndpoint("", retries), want: true},
+		{name: "POST", method: http.MethodPost, endpoint: httpEndpoint("", retries)},
+		{name: "PATCH", method: http.MethodPatch, endpoint: httpEndpoint("", retries)},
+		{name: "endpoint method", method: http.MethodPost, endpoint: httpEndpoint(http.MethodPut, retries), want: true},
+		{name: "non-idempotent endpoint method", method: http.MethodGet, endpoint: httpEndpoint(http.MethodPost, retries)},
+		{
+			name:     "retry non-idempotent",
+			method:   http.MethodPost,
+			endpoint: httpEndpoint("", &pb.RetryPolicy{MaxAttempts: 3, RetryNonIdempotent: true}),
+			want:     true,
+		},
+		{
+			// The streamed body can't be replayed.
+			name:        "primary only",
+			method:      http.MethodGet,
+			endpoint:    httpEndpoint("", &pb.RetryPolicy{MaxAttempts: 3, RetryNonIdempotent: true}),
+			primaryOnly: true,
+		},
+		{
+			name:   "fanout endpoint",
+			method: http.MethodGet,
+			endpoint: &pb.Endpoint{Name: "e", RetryPolicy: retries, Destination: &pb.Endpoint_FanoutEndpoint{
+				FanoutEndpoint: &pb.FanoutEndpoint{Fanout: "f"},
+			}},
+			want: true,
+		},
+		{
+			name:   "Twirp endpoint",
+			method: http.MethodPost,
+			endpoint: &pb.Endpoint{Name: "e", RetryPolicy: retries, Destination: &pb.Endpoint_TwirpEndpoint{
+				TwirpEndpoint: &pb.TwirpEndpoint{BaseUrl: "http://127.0.0.1:1", Service: "s", Method: "m"},
+			}},
+		},
+		{
+			name:   "retry non-idempotent Twirp endpoint",
+			method: http.MethodPost,
+			endpoint: &pb.Endpoint{Name: "e", RetryPolicy: &pb.RetryPolicy{MaxAttempts: 3, RetryNonIdempotent: true}, Destination: &pb.Endpoint_TwirpEndpoint{
+				TwirpEndpoint: &pb.TwirpEndpoint{BaseUrl: "http://127.0.0.1:1", Service: "s", Method: "m"},
+			}},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		worker := &Worker{primaryOnly: tt.primaryOnly}
+		r := httptest.NewRequest(tt.method, "/fanout/f", nil)
+		if got := worker.canRetry(r, tt.endpoint); got != tt.want {
+			t.Errorf("%s: canRetry() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestShouldRetry(t *testing.T) {
+	policy := &pb.RetryPolicy{MaxAttempts: 3, RetryableStatusCodes: []int32{429, 502, 503}}
+	networkPolicy := &pb.RetryPolicy{MaxAttempts: 3, RetryNetworkErrors: true}
+	tests := []struct {
+		name   string
+		policy *pb.RetryPolicy
+		code   int
+		err    error
+		want   bool
+	}{
+		{name: "retryable status", policy: policy, code: 503, want: true},
+		{name: "another retryable status", policy: policy, code: 429, want: true},
+		{name: "status not listed", policy: policy, code: 500},
+		{name: "success", policy: policy, code: 200},
+		{name: "no statuses", policy: networkPolicy, code: 503},
+		{name: "network error", policy: networkPolicy, err: syscall.ECONNREFUSED, want: true},
+		{name: "network errors not retried", policy: policy, err: syscall.ECONNREFUSED},
+		{name: "other error", policy: networkPolicy, err: errors.New("invalid URL")},
+	}
+	for _, tt := range tests {
+		var resp *workerResponse
+		if tt.err == nil {
+			resp = &workerResponse{code: tt.code}
+		}
+		if got := shouldRetry(tt.policy, resp, tt.err); got != tt.want {
+			t.Errorf("%s: shouldRetry() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIsNetworkError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "connection refused", err: fmt.Errorf("dial: %w", syscall.ECONNREFUSED), want: true},
+		{name: "connection reset", err: syscall.ECONNRESET, want: true},
+		{name: "unexpected EOF", err: io.ErrUnexpectedEOF, want: true},
+		{name: "EOF", err: io.EOF, want: true},
+		{name: "deadline", err: context.DeadlineExceeded, want: true},
+		{name: "net.OpError", err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("no route to host")}, want: true},
+		{name: "DNS timeout", err: &net.DNSError{Err: "timeout", IsTimeout: true}, want: true},
+		{name: "gRPC unavailable", err: status.Error(codes.Unavailable, "unavailable"), want: true},
+		{name: "gRPC deadline exceeded", err: status.Error(codes.DeadlineExceeded, "deadline"), want: true},
+		{name: "gRPC invalid argument", err: status.Error(codes.InvalidArgument, "invalid")},
+		{name: "canceled", err: context.Canceled},
+		{name: "other error", err: errors.New("invalid URL")},
+	}
+	for _, tt := range tests {
+		if got := isNetworkError(tt.err); got != tt.want {
+			t.Errorf("%s: isNetworkError() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestJitter(t *testing.T) {
+	tests := []struct {
+		fraction float64
+		min, max time.Duration
+	}{
+		{fraction: 0, min: time.Second, max: time.Second},
+		{fraction: -1, min: time.Second, max: time.Second},
+		{fraction: 0.2, min: 800 * time.Millisecond, max: 1200 * time.Millisecond},
+		{fraction: 0.5, min: 500 * time.Millisecond, max: 1500 * time.Millisecond},
+		{fraction: 1, min: 0, max: 2 * time.Second},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			if got := jitter(time.Second, tt.fraction); got < tt.min || got > tt.max {
+				t.Errorf("jitter(1s, %v) = %v, want in [%v, %v]", tt.fraction, got, tt.min, tt.max)
+				break
+			}
+		}
+	}
+}
+
+func TestRetries(t *testing.T) {
+	// The server fails the first attempts of each path
+	// with the status in the path, e.g. "/503/2".
+	var (
+		mu       sync.Mutex
+		attempts = make(map[string]int)
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		attempts[r.URL.Path]++
+		n := attempts[r.URL.Path]
+		mu.Unlock()
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		code, _ := strconv.Atoi(parts[0])
+		failures, _ := strconv.Atoi(parts[1])
+		if n <= failures {
+			w.WriteHeader(code)
+		}
+	}))
+	defer srv.Close()
+
+	policy := &pb.RetryPolicy{
+		MaxAttempts:          3,
+		InitialBackoffMs:     1,
+		MaxBackoffMs:         2,
+		Jitter:               0.5,
+		RetryableStatusCodes: []int32{503},
+	}
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		status   int
+		attempts int
+	}{
+		{name: "succeeds", method: http.MethodGet, path: "/503/0", status: http.StatusOK, attempts: 1},
+		{name: "succeeds after retries", method: http.MethodGet, path: "/503/2", status: http.StatusOK, attempts: 3},
+		{name: "max attempts", method: http.MethodGet, path: "/503/5", status: http.StatusServiceUnavailable, attempts: 3},
+		{name: "status not retried", method: http.MethodGet, path: "/500/5", status: http.StatusInternalServerError, attempts: 1},
+		{name: "non-idempotent", method: http.MethodPost, path: "/503/5", status: http.StatusServiceUnavailable, attempts: 1},
+	}
+
+	fanouts := make(map[string]*pb.GetFanoutResponse)
+	run := testRuns.Add(1)
+	name := func(i int) string {
+		return fmt.Sprintf("retries-%d-%d", run, i)
+	}
+	for i, tt := range tests {
+		fanouts[name(i)] = &pb.GetFanoutResponse{Endpoints: []*pb.Endpoint{{
+			Name:        "e",
+			Primary:     true,
+			RetryPolicy: policy,
+			Destination: &pb.Endpoint_HttpEndpoint{HttpEndpoint: &pb.HTTPEndpoint{Url: srv.URL + tt.path}},
+		}}}
+	}
+	r := mux.NewRouter()
+	r.Handle("/fanout/{name}", newTestHandler(fanouts))
+	front := httptest.NewServer(r)
+	defer front.Close()
+
+	for i, tt := range tests {
+		req, err := http.NewRequest(tt.method, front.URL+"/fanout/"+name(i), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != tt.status {
+			t.Errorf("%s: status = %d, want %d", tt.name, resp.StatusCode, tt.status)
+		}
+		mu.Lock()
+		got := attempts[tt.path]
+		delete(attempts, tt.path)
+		mu.Unlock()
+		if got != tt.attempts {
+			t.Errorf("%s: %d attempts, want %d", tt.name, got, tt.attempts)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package fanout
+
+import (
+	"bytes"
+	"encoding/json"
+	"hash/fnv"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+
+	pb "github.com/dfanout/dfanout/proto"
+)
+
+// sampled reports whether the request is sent to the endpoint
+// according to the endpoint's sampling.
+func (worker *Worker) sampled(r *http.Request, endpoint *pb.Endpoint) bool {
+	s := endpoint.Sampling
+	if s == nil || endpoint.Primary || s.Percentage >= 100 {
+		return true
+	}
+	if s.Percentage <= 0 {
+		return false
+	}
+
+	// Buckets have a precision of a hundredth of a percent.
+	threshold := uint64(s.Percentage * 100)
+	key, ok := worker.samplingKey(r, s)
+	if !ok {
+		return uint64(rand.Int63n(10000)) < threshold
+	}
+	// Hash the endpoint name with the key, so endpoints
+	// sampled with the same key select different cohorts.
+	h := fnv.New64a()
+	h.Write([]byte(endpoint.Name))
+	h.Write([]byte{0})
+	h.Write([]byte(key))
+	return h.Sum64()%10000 < threshold
+}
+
+// samplingKey returns the value of the request
+// attribute that selects the requests.
+func (worker *Worker) samplingKey(r *http.Request, s *pb.Sampling) (string, bool) {
+	switch k := s.Key.(type) {
+	case *pb.Sampling_Header:
+		v := r.Header.Get(k.Header)
+		return v, v != ""
+	case *pb.Sampling_QueryParam:
+		q := r.URL.Query()
+		return q.Get(k.QueryParam), q.Has(k.QueryParam)
+	case *pb.Sampling_JsonField:
+		return jsonField(worker.jsonBody(), k.JsonField)
+	}
+	return "", false
+}
+
+// jsonBody decodes the request body as JSON once per request.
+// It returns nil if the body is not JSON.
+func (worker *Worker) jsonBody() any {
+	if worker.decodedBody {
+		return worker.decoded
+	}
+	worker.decodedBody = true
+	if worker.primaryOnly || worker.body.Size() == 0 {
+		return nil
+	}
+	dec := json.NewDecoder(worker.body.NewReader())
+	dec.UseNumber()
+	if err := dec.Decode(&worker.decoded); err != nil {
+		worker.decoded = nil
+	}
+	return worker.decoded
+}
+
+// jsonField returns the field at the dot separated path
+// of v as a string. Strings are returned unquoted, other
+// values are returned JSON encoded.
+func jsonField(v any, path string) (string, bool) {
+	for _, p := range strings.Split(path, ".") {
+		switch vv := v.(type) {
+		case map[string]any:
+			var ok bool
+			if v, ok = vv[p]; !ok {
+				return "", false
+			}
+		case []any:
+			i, err := strconv.Atoi(p)
+			if err != nil || i < 0 || i >= len(vv) {
+				return "", false
+			}
+			v = vv[i]
+		default:
+			return "", false
+		}
+	}
+	switch vv := v.(type) {
+	case nil:
+		return "", false
+	case string:
+		return vv, true
+	case json.Number:
+		return vv.String(), true
+	}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		return "", false
+	}
+	return strings.TrimSpace(buf.String()), true
+}
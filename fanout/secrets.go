@@ -0,0 +1,74 @@
+package fanout
+
+import (
+	"context"
+	"net/http"
+
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/dfanout/dfanout/secrets"
+)
+
+// addSecretHeaders adds the values of the secrets
+// referenced by the endpoint's headers.
+func (h *Handler) addSecretHeaders(ctx context.Context, header http.Header, headers []*pb.Header) error {
+	for _, hdr := range headers {
+		if hdr.ValueRef == "" {
+			continue
+		}
+		v, err := h.Secrets.Resolve(ctx, hdr.ValueRef)
+		if err != nil {
+			return err
+		}
+		header.Add(hdr.Key, string(v))
+	}
+	return nil
+}
+
+// endpointHeaders returns the headers configured for the endpoint.
+func endpointHeaders(endpoint *pb.Endpoint) []*pb.Header {
+	switch d := endpoint.Destination.(type) {
+	case *pb.Endpoint_HttpEndpoint:
+		return d.HttpEndpoint.Header
+	case *pb.Endpoint_TwirpEndpoint:
+		return d.TwirpEndpoint.Header
+	case *pb.Endpoint_GrpcEndpoint:
+		return d.GrpcEndpoint.Metadata
+	}
+	return nil
+}
+
+// removeSecretHeaders removes the headers with secret values,
+// e.g. before the request is persisted.
+func removeSecretHeaders(header http.Header, headers []*pb.Header) {
+	for _, hdr := range headers {
+		if hdr.ValueRef != "" {
+			header.Del(hdr.Key)
+		}
+	}
+}
+
+// redactHeaders redacts the values of the headers with
+// secret values and the headers that carry credentials.
+func redactHeaders(header http.Header, headers []*pb.Header) {
+	for key, values := range header {
+		if secrets.SensitiveHeader(key) {
+			for i := range values {
+				values[i] = secrets.Redacted
+			}
+		}
+	}
+	for _, hdr := range headers {
+		if values := header.Values(hdr.Key); hdr.ValueRef != "" && len(values) > 0 {
+			for i := range values {
+				values[i] = secrets.Redacted
+			}
+		}
+	}
+}
+
+// InvalidateSecret refreshes the clients and the authenticators
+// that use the referenced secret, once its value changes.
+func (h *Handler) InvalidateSecret(ref string) {
+	h.ClientCache.InvalidateSecret(ref)
+	h.authenticators.reset()
+}
@@ -0,0 +1,87 @@
+package fanout
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/dfanout/dfanout/debug"
+	pb "github.com/dfanout/dfanout/proto"
+)
+
+// stats keeps the runtime stats of endpoints
+// served by this node for the debug page.
+type stats struct {
+	endpoints sync.Map // statsKey -> *endpointStats
+}
+
+type statsKey struct {
+	fanout   string
+	endpoint string
+}
+
+type endpointStats struct {
+	requests  atomic.Int64
+	attempts  atomic.Int64
+	failures  atomic.Int64
+	lastError atomic.Value // string
+}
+
+func (s *stats) endpoint(fanout, endpoint string) *endpointStats {
+	key := statsKey{fanout: fanout, endpoint: endpoint}
+	if v, ok := s.endpoints.Load(key); ok {
+		return v.(*endpointStats)
+	}
+	v, _ := s.endpoints.LoadOrStore(key, &endpointStats{})
+	return v.(*endpointStats)
+}
+
+// record records a request to an endpoint that took
+// the given number of attempts and ended with err.
+func (s *stats) record(fanout, endpoint string, attempts int, err error) {
+	e := s.endpoint(fanout, endpoint)
+	e.requests.Add(1)
+	e.attempts.Add(int64(attempts))
+	if err != nil {
+		e.failures.Add(1)
+		e.lastError.Store(err.Error())
+	}
+}
+
+// debugStatus returns the status of the fanout's endpoints for the debug page.
+func (h *Handler) debugStatus(fanout string, endpoints []*pb.Endpoint) map[string]debug.Status {
+	status := h.stats.status(fanout, endpoints)
+	for _, e := range endpoints {
+		if b := h.breakers.get(fanout, e); b != nil {
+			s := status[e.Name]
+			s.Breaker = b.State().String()
+			status[e.Name] = s
+		}
+	}
+	if h.Mismatches == nil {
+		return status
+	}
+	for _, r := range h.Mismatches.Report(fanout, "") {
+		if s, ok := status[r.Endpoint]; ok {
+			s.Compared = r.Compared
+			s.Mismatched = r.Mismatched
+			status[r.Endpoint] = s
+		}
+	}
+	return status
+}
+
+// status returns the request stats of the fanout's endpoints.
+func (s *stats) status(fanout string, endpoints []*pb.Endpoint) map[string]debug.Status {
+	status := make(map[string]debug.Status, len(endpoints))
+	for _, endpoint := range endpoints {
+		e := s.endpoint(fanout, endpoint.Name)
+		lastError, _ := e.lastError.Load().(string)
+		status[endpoint.Name] = debug.Status{
+			Requests:  e.requests.Load(),
+			Attempts:  e.attempts.Load(),
+			Failures:  e.failures.Load(),
+			LastError: lastError,
+		}
+	}
+	return status
+}
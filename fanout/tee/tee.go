@@ -0,0 +1,146 @@
+// Package tee buffers an inbound request body once and
+// replays it to any number of readers.
+package tee
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"sync/atomic"
+)
+
+const (
+	DefaultMemoryLimit = 1 << 20  // 1 MB
+	DefaultMaxSize     = 32 << 20 // 32 MB
+)
+
+// ErrTooLarge is returned when the body is larger than
+// the maximum size that can be mirrored.
+var ErrTooLarge = errors.New("tee: body is too large to mirror")
+
+type Options struct {
+	// MemoryLimit is the number of bytes buffered in memory
+	// before the body is spilled to disk.
+	MemoryLimit int64
+
+	// MaxSize is the maximum number of bytes that can be buffered.
+	MaxSize int64
+
+	// Dir is the directory spilled bodies are written to.
+	// If empty, the default temporary directory is used.
+	Dir string
+}
+
+// Body is a buffered request body. Each call to NewReader
+// returns an independent reader that starts from the
+// beginning of the body.
+//
+// Bodies are reference counted, so requests that outlive the
+// inbound request can keep reading the body. New returns a body
+// with one reference, Acquire adds a reference and Close releases one.
+type Body struct {
+	mem  []byte
+	file *os.File
+	size int64
+	refs atomic.Int32
+}
+
+// New reads r until EOF and buffers it.
+//
+// If r is larger than opts.MaxSize, New returns ErrTooLarge and
+// a Body that holds the bytes read so far. Callers can use
+// Remainder to stream the full body to a single destination.
+func New(r io.Reader, opts Options) (*Body, error) {
+	if opts.MemoryLimit <= 0 {
+		opts.MemoryLimit = DefaultMemoryLimit
+	}
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = DefaultMaxSize
+	}
+	if opts.MemoryLimit > opts.MaxSize {
+		opts.MemoryLimit = opts.MaxSize
+	}
+
+	b := &Body{}
+	b.refs.Store(1)
+	if r == nil || r == http.NoBody {
+		return b, nil
+	}
+
+	var buf bytes.Buffer
+	n, err := io.Copy(&buf, io.LimitReader(r, opts.MemoryLimit+1))
+	if err != nil {
+		return nil, err
+	}
+	if n <= opts.MemoryLimit {
+		b.mem = buf.Bytes()
+		b.size = n
+		return b, nil
+	}
+
+	// Spill to disk.
+	f, err := os.CreateTemp(opts.Dir, "dfanout-body-")
+	if err != nil {
+		return nil, err
+	}
+	// Unlink right away; the open file descriptor keeps
+	// the data around until the body is closed.
+	os.Remove(f.Name())
+	b.file = f
+
+	n, err = io.Copy(f, io.MultiReader(&buf, io.LimitReader(r, opts.MaxSize-n+1)))
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	b.size = n
+	if n > opts.MaxSize {
+		return b, ErrTooLarge
+	}
+	return b, nil
+}
+
+// Size returns the number of buffered bytes.
+func (b *Body) Size() int64 {
+	return b.size
+}
+
+// NewReader returns a new reader over the buffered body.
+func (b *Body) NewReader() io.Reader {
+	if b.file != nil {
+		return io.NewSectionReader(b.file, 0, b.size)
+	}
+	return bytes.NewReader(b.mem)
+}
+
+// Bytes returns the buffered body if it isn't spilled to disk.
+// The returned slice must not be modified.
+func (b *Body) Bytes() ([]byte, bool) {
+	if b.file != nil {
+		return nil, false
+	}
+	return b.mem, true
+}
+
+// Remainder returns a reader that replays the buffered bytes and
+// continues with the rest of r. It is used to stream an oversized
+// body to a single destination.
+func (b *Body) Remainder(r io.Reader) io.Reader {
+	return io.MultiReader(b.NewReader(), r)
+}
+
+// Acquire adds a reference to the body.
+func (b *Body) Acquire() {
+	b.refs.Add(1)
+}
+
+// Close releases a reference to the body. The resources
+// held by the body are released with the last reference.
+func (b *Body) Close() error {
+	if b.refs.Add(-1) > 0 || b.file == nil {
+		return nil
+	}
+	return b.file.Close()
+}
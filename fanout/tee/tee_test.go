@@ -0,0 +1,88 @@
+package tee
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	opts := Options{MemoryLimit: 4, MaxSize: 8, Dir: t.TempDir()}
+	tests := []struct {
+		name    string
+		body    io.Reader
+		want    string
+		spilled bool
+		wantErr error
+	}{
+		{name: "nil", body: nil},
+		{name: "no body", body: http.NoBody},
+		{name: "in memory", body: strings.NewReader("abcd"), want: "abcd"},
+		{name: "spilled", body: strings.NewReader("abcde"), want: "abcde", spilled: true},
+		{name: "max size", body: strings.NewReader("abcdefgh"), want: "abcdefgh", spilled: true},
+		{name: "too large", body: strings.NewReader("abcdefghij"), want: "abcdefghi", spilled: true, wantErr: ErrTooLarge},
+	}
+	for _, tt := range tests {
+		b, err := New(tt.body, opts)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: New() error = %v, want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if b.Size() != int64(len(tt.want)) {
+			t.Errorf("%s: Size() = %d, want %d", tt.name, b.Size(), len(tt.want))
+		}
+		// Readers are independent and start from the beginning.
+		r1, r2 := b.NewReader(), b.NewReader()
+		for _, r := range []io.Reader{r1, r2, b.NewReader()} {
+			got, err := io.ReadAll(r)
+			if err != nil || string(got) != tt.want {
+				t.Errorf("%s: read %q, %v, want %q", tt.name, got, err, tt.want)
+			}
+		}
+		if mem, ok := b.Bytes(); ok == tt.spilled || (ok && string(mem) != tt.want) {
+			t.Errorf("%s: Bytes() = %q, %v, want spilled: %v", tt.name, mem, ok, tt.spilled)
+		}
+		b.Close()
+	}
+}
+
+func TestRemainder(t *testing.T) {
+	r := strings.NewReader("abcdefghijkl")
+	b, err := New(r, Options{MemoryLimit: 2, MaxSize: 4, Dir: t.TempDir()})
+	if !errors.Is(err, ErrTooLarge) {
+		t.Fatalf("New() error = %v, want ErrTooLarge", err)
+	}
+	defer b.Close()
+	got, err := io.ReadAll(b.Remainder(r))
+	if err != nil || string(got) != "abcdefghijkl" {
+		t.Errorf("Remainder() read %q, %v, want the full body", got, err)
+	}
+}
+
+func TestRefs(t *testing.T) {
+	b, err := New(bytes.NewReader(make([]byte, 16)), Options{MemoryLimit: 4, Dir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Acquire()
+	b.Acquire()
+	for i := 0; i < 2; i++ {
+		if err := b.Close(); err != nil {
+			t.Fatal(err)
+		}
+		// The body is readable while it has references.
+		if n, err := io.Copy(io.Discard, b.NewReader()); n != 16 || err != nil {
+			t.Fatalf("read %d bytes, %v, want 16 bytes with %d references", n, err, 2-i)
+		}
+	}
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+	// The spilled file is closed with the last reference.
+	if _, err := io.Copy(io.Discard, b.NewReader()); err == nil {
+		t.Error("read the body after the last reference was released")
+	}
+}
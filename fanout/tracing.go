@@ -0,0 +1,80 @@
+package fanout
+
+import (
+	"context"
+	"net/http"
+
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/dfanout/dfanout/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Span attributes.
+const (
+	attrFanout   = attribute.Key("dfanout.fanout")
+	attrEndpoint = attribute.Key("dfanout.endpoint")
+	attrPrimary  = attribute.Key("dfanout.primary")
+	attrRetries  = attribute.Key("dfanout.retries")
+	attrOutcome  = attribute.Key("dfanout.outcome")
+	attrStatus   = attribute.Key("http.status_code")
+	attrMethod   = attribute.Key("http.method")
+)
+
+// startServerSpan starts the span of a fanout. Fanouts called by
+// other fanouts in process are children of the calling endpoint's span.
+func startServerSpan(r *http.Request, fanout string) (context.Context, trace.Span) {
+	ctx := r.Context()
+	kind := trace.SpanKindInternal
+	if len(fanoutChain(ctx)) == 0 {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(r.Header))
+		kind = trace.SpanKindServer
+	}
+	return tracing.Tracer().Start(ctx, "fanout "+fanout,
+		trace.WithSpanKind(kind),
+		trace.WithAttributes(
+			attrFanout.String(fanout),
+			attrMethod.String(r.Method),
+		),
+	)
+}
+
+func endServerSpan(span trace.Span, code int) {
+	span.SetAttributes(attrStatus.Int(code))
+	if code >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(code))
+	}
+}
+
+// startClientSpan starts the span of a request to an endpoint,
+// including all of its attempts.
+func startClientSpan(ctx context.Context, fanout string, endpoint *pb.Endpoint) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, "endpoint "+endpoint.Name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attrFanout.String(fanout),
+			attrEndpoint.String(endpoint.Name),
+			attrPrimary.Bool(endpoint.Primary),
+		),
+	)
+}
+
+func endClientSpan(span trace.Span, attempts int, resp *workerResponse, err error) {
+	span.SetAttributes(attrRetries.Int(max(attempts-1, 0)))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.SetAttributes(attrOutcome.String("error"))
+		return
+	}
+	span.SetAttributes(attrStatus.Int(resp.code))
+	if resp.code >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, http.StatusText(resp.code))
+		span.SetAttributes(attrOutcome.String("failure"))
+		return
+	}
+	span.SetAttributes(attrOutcome.String("success"))
+}
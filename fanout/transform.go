@@ -0,0 +1,54 @@
+package fanout
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/dfanout/dfanout/fanout/transform"
+	pb "github.com/dfanout/dfanout/proto"
+)
+
+type transformedBodyKey struct{}
+
+// transformedBody returns the request body transformed
+// for the endpoint, if the endpoint transforms the body.
+func transformedBody(ctx context.Context) ([]byte, bool) {
+	b, ok := ctx.Value(transformedBodyKey{}).([]byte)
+	return b, ok
+}
+
+// applyTransform returns the request to send to the
+// endpoint after applying the endpoint's transform.
+// Body operations are not applied to empty bodies.
+func (worker *Worker) applyTransform(r *http.Request, endpoint *pb.Endpoint) (*http.Request, error) {
+	t := endpoint.Transform
+	if t == nil {
+		return r, nil
+	}
+	proxyReq := r.Clone(r.Context())
+	transform.Header(proxyReq.Header, t.Headers)
+	if len(t.Query) > 0 {
+		query := proxyReq.URL.Query()
+		transform.Query(query, t.Query)
+		proxyReq.URL.RawQuery = query.Encode()
+	}
+	if len(t.Body) == 0 || (!worker.primaryOnly && worker.body.Size() == 0) {
+		return proxyReq, nil
+	}
+	if worker.primaryOnly {
+		return nil, errors.New("body is too large to transform")
+	}
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, worker.body.NewReader()); err != nil {
+		return nil, err
+	}
+	body, err := transform.Body(buf.Bytes(), t.Body)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.WithValue(proxyReq.Context(), transformedBodyKey{}, body)
+	return proxyReq.WithContext(ctx), nil
+}
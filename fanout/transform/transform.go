@@ -0,0 +1,307 @@
+// Package transform applies the transforms configured
+// for an endpoint to the inbound request.
+package transform
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	pb "github.com/dfanout/dfanout/proto"
+)
+
+// Header applies the header operations to h.
+func Header(h http.Header, ops []*pb.HeaderOperation) {
+	for _, op := range ops {
+		switch o := op.Operation.(type) {
+		case *pb.HeaderOperation_Set:
+			h.Del(o.Set.Key)
+			for _, v := range o.Set.Values {
+				h.Add(o.Set.Key, v)
+			}
+		case *pb.HeaderOperation_Add:
+			for _, v := range o.Add.Values {
+				h.Add(o.Add.Key, v)
+			}
+		case *pb.HeaderOperation_Remove:
+			h.Del(o.Remove)
+		case *pb.HeaderOperation_Rename:
+			vals := h.Values(o.Rename.From)
+			if len(vals) == 0 {
+				continue
+			}
+			vals = append([]string(nil), vals...)
+			h.Del(o.Rename.From)
+			h.Del(o.Rename.To)
+			for _, v := range vals {
+				h.Add(o.Rename.To, v)
+			}
+		}
+	}
+}
+
+// Query applies the query operations to q.
+func Query(q url.Values, ops []*pb.QueryOperation) {
+	for _, op := range ops {
+		switch o := op.Operation.(type) {
+		case *pb.QueryOperation_Set:
+			q[o.Set.Key] = append([]string(nil), o.Set.Values...)
+		case *pb.QueryOperation_Add:
+			q[o.Add.Key] = append(q[o.Add.Key], o.Add.Values...)
+		case *pb.QueryOperation_Remove:
+			q.Del(o.Remove)
+		case *pb.QueryOperation_Rename:
+			vals, ok := q[o.Rename.From]
+			if !ok {
+				continue
+			}
+			delete(q, o.Rename.From)
+			q[o.Rename.To] = vals
+		}
+	}
+}
+
+// Body applies the body operations to a JSON body and returns
+// the re-encoded body. Fields that don't exist are ignored by
+// the delete, move and rename operations.
+func Body(body []byte, ops []*pb.BodyOperation) ([]byte, error) {
+	if len(ops) == 0 {
+		return body, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var root any
+	if err := dec.Decode(&root); err != nil {
+		return nil, fmt.Errorf("body is not JSON: %w", err)
+	}
+
+	for _, op := range ops {
+		var err error
+		switch o := op.Operation.(type) {
+		case *pb.BodyOperation_Set:
+			var v any
+			dec := json.NewDecoder(strings.NewReader(o.Set.Value))
+			dec.UseNumber()
+			if err := dec.Decode(&v); err != nil {
+				return nil, fmt.Errorf("invalid value for %q: %w", o.Set.Path, err)
+			}
+			root, err = set(root, split(o.Set.Path), v)
+		case *pb.BodyOperation_Delete:
+			root = remove(root, split(o.Delete))
+		case *pb.BodyOperation_Move:
+			root, err = move(root, split(o.Move.From), split(o.Move.To))
+		case *pb.BodyOperation_Rename:
+			from := split(o.Rename.From)
+			to := append(append([]string(nil), from[:len(from)-1]...), o.Rename.To)
+			root, err = move(root, from, to)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(root)
+}
+
+// Validate validates the transform.
+func Validate(t *pb.Transform) error {
+	for i, op := range t.GetHeaders() {
+		if err := validateOperation(op.Operation); err != nil {
+			return fmt.Errorf("header operation %d: %w", i, err)
+		}
+	}
+	for i, op := range t.GetQuery() {
+		if err := validateOperation(op.Operation); err != nil {
+			return fmt.Errorf("query operation %d: %w", i, err)
+		}
+	}
+	for i, op := range t.GetBody() {
+		if err := validateBodyOperation(op); err != nil {
+			return fmt.Errorf("body operation %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func validateOperation(op any) error {
+	switch o := op.(type) {
+	case *pb.HeaderOperation_Set:
+		return validateName(o.Set.GetKey())
+	case *pb.HeaderOperation_Add:
+		return validateName(o.Add.GetKey())
+	case *pb.HeaderOperation_Remove:
+		return validateName(o.Remove)
+	case *pb.HeaderOperation_Rename:
+		return validateRename(o.Rename)
+	case *pb.QueryOperation_Set:
+		return validateName(o.Set.GetKey())
+	case *pb.QueryOperation_Add:
+		return validateName(o.Add.GetKey())
+	case *pb.QueryOperation_Remove:
+		return validateName(o.Remove)
+	case *pb.QueryOperation_Rename:
+		return validateRename(o.Rename)
+	}
+	return errors.New("missing operation")
+}
+
+func validateName(name string) error {
+	if name == "" {
+		return errors.New("missing name")
+	}
+	return nil
+}
+
+func validateRename(r *pb.Rename) error {
+	if r.GetFrom() == "" || r.GetTo() == "" {
+		return errors.New("rename needs both from and to")
+	}
+	return nil
+}
+
+func validateBodyOperation(op *pb.BodyOperation) error {
+	switch o := op.Operation.(type) {
+	case *pb.BodyOperation_Set:
+		if err := validatePath(o.Set.GetPath()); err != nil {
+			return err
+		}
+		if !json.Valid([]byte(o.Set.GetValue())) {
+			return fmt.Errorf("value of %q is not JSON", o.Set.GetPath())
+		}
+	case *pb.BodyOperation_Delete:
+		return validatePath(o.Delete)
+	case *pb.BodyOperation_Move:
+		if err := validatePath(o.Move.GetFrom()); err != nil {
+			return err
+		}
+		return validatePath(o.Move.GetTo())
+	case *pb.BodyOperation_Rename:
+		if err := validatePath(o.Rename.GetFrom()); err != nil {
+			return err
+		}
+		if to := o.Rename.GetTo(); to == "" || strings.Contains(to, ".") {
+			return fmt.Errorf("invalid field name %q", to)
+		}
+	default:
+		return errors.New("missing operation")
+	}
+	return nil
+}
+
+func validatePath(path string) error {
+	for _, p := range split(path) {
+		if p == "" {
+			return fmt.Errorf("invalid path %q", path)
+		}
+	}
+	return nil
+}
+
+func split(path string) []string {
+	return strings.Split(path, ".")
+}
+
+func get(v any, path []string) (any, bool) {
+	for _, p := range path {
+		switch vv := v.(type) {
+		case map[string]any:
+			var ok bool
+			if v, ok = vv[p]; !ok {
+				return nil, false
+			}
+		case []any:
+			i, ok := index(vv, p)
+			if !ok {
+				return nil, false
+			}
+			v = vv[i]
+		default:
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// set sets the value at path and returns the root, which
+// is replaced with an object if it is not a container.
+func set(root any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	p, rest := path[0], path[1:]
+	switch v := root.(type) {
+	case map[string]any:
+		child, err := set(v[p], rest, value)
+		if err != nil {
+			return nil, err
+		}
+		v[p] = child
+		return v, nil
+	case []any:
+		i, ok := index(v, p)
+		if !ok {
+			return nil, fmt.Errorf("index %q is out of range", p)
+		}
+		child, err := set(v[i], rest, value)
+		if err != nil {
+			return nil, err
+		}
+		v[i] = child
+		return v, nil
+	default:
+		child, err := set(nil, rest, value)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{p: child}, nil
+	}
+}
+
+// remove removes the value at path and returns the root.
+func remove(root any, path []string) any {
+	p, rest := path[0], path[1:]
+	switch v := root.(type) {
+	case map[string]any:
+		child, ok := v[p]
+		if !ok {
+			return v
+		}
+		if len(rest) == 0 {
+			delete(v, p)
+			return v
+		}
+		v[p] = remove(child, rest)
+		return v
+	case []any:
+		i, ok := index(v, p)
+		if !ok {
+			return v
+		}
+		if len(rest) == 0 {
+			return append(v[:i:i], v[i+1:]...)
+		}
+		v[i] = remove(v[i], rest)
+		return v
+	}
+	return root
+}
+
+func move(root any, from, to []string) (any, error) {
+	v, ok := get(root, from)
+	if !ok {
+		return root, nil
+	}
+	return set(remove(root, from), to, v)
+}
+
+func index(arr []any, p string) (int, bool) {
+	i, err := strconv.Atoi(p)
+	if err != nil || i < 0 || i >= len(arr) {
+		return 0, false
+	}
+	return i, true
+}
@@ -0,0 +1,289 @@
+package transform
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	pb "github.com/dfanout/dfanout/proto"
+)
+
+func setHeader(key string, values ...string) *pb.HeaderOperation {
+	return &pb.HeaderOperation{Operation: &pb.HeaderOperation_Set{Set: &pb.Header{Key: key, Values: values}}}
+}
+
+func addHeader(key string, values ...string) *pb.HeaderOperation {
+	return &pb.HeaderOperation{Operation: &pb.HeaderOperation_Add{Add: &pb.Header{Key: key, Values: values}}}
+}
+
+func removeHeader(key string) *pb.HeaderOperation {
+	return &pb.HeaderOperation{Operation: &pb.HeaderOperation_Remove{Remove: key}}
+}
+
+func renameHeader(from, to string) *pb.HeaderOperation {
+	return &pb.HeaderOperation{Operation: &pb.HeaderOperation_Rename{Rename: &pb.Rename{From: from, To: to}}}
+}
+
+func TestHeader(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  []*pb.HeaderOperation
+		want http.Header
+	}{
+		{
+			name: "no operations",
+			want: http.Header{"X-A": {"1", "2"}, "X-B": {"b"}},
+		},
+		{
+			name: "set replaces the values",
+			ops:  []*pb.HeaderOperation{setHeader("x-a", "3")},
+			want: http.Header{"X-A": {"3"}, "X-B": {"b"}},
+		},
+		{
+			name: "set a new header",
+			ops:  []*pb.HeaderOperation{setHeader("X-C", "c1", "c2")},
+			want: http.Header{"X-A": {"1", "2"}, "X-B": {"b"}, "X-C": {"c1", "c2"}},
+		},
+		{
+			name: "add",
+			ops:  []*pb.HeaderOperation{addHeader("X-B", "b2")},
+			want: http.Header{"X-A": {"1", "2"}, "X-B": {"b", "b2"}},
+		},
+		{
+			name: "remove",
+			ops:  []*pb.HeaderOperation{removeHeader("x-a"), removeHeader("X-Missing")},
+			want: http.Header{"X-B": {"b"}},
+		},
+		{
+			name: "rename replaces the target",
+			ops:  []*pb.HeaderOperation{renameHeader("X-A", "X-B")},
+			want: http.Header{"X-B": {"1", "2"}},
+		},
+		{
+			name: "rename a missing header",
+			ops:  []*pb.HeaderOperation{renameHeader("X-Missing", "X-B")},
+			want: http.Header{"X-A": {"1", "2"}, "X-B": {"b"}},
+		},
+		{
+			name: "operations are applied in order",
+			ops:  []*pb.HeaderOperation{removeHeader("X-A"), addHeader("X-A", "4"), renameHeader("X-A", "X-D")},
+			want: http.Header{"X-B": {"b"}, "X-D": {"4"}},
+		},
+	}
+	for _, tt := range tests {
+		h := http.Header{"X-A": {"1", "2"}, "X-B": {"b"}}
+		Header(h, tt.ops)
+		if !reflect.DeepEqual(h, tt.want) {
+			t.Errorf("%s: Header() = %v, want %v", tt.name, h, tt.want)
+		}
+	}
+}
+
+func TestQuery(t *testing.T) {
+	op := func(o any) *pb.QueryOperation {
+		switch o := o.(type) {
+		case *pb.QueryOperation_Set:
+			return &pb.QueryOperation{Operation: o}
+		case *pb.QueryOperation_Add:
+			return &pb.QueryOperation{Operation: o}
+		case *pb.QueryOperation_Remove:
+			return &pb.QueryOperation{Operation: o}
+		case *pb.QueryOperation_Rename:
+			return &pb.QueryOperation{Operation: o}
+		}
+		panic("unknown operation")
+	}
+	tests := []struct {
+		name string
+		ops  []*pb.QueryOperation
+		want string
+	}{
+		{
+			name: "set",
+			ops:  []*pb.QueryOperation{op(&pb.QueryOperation_Set{Set: &pb.Header{Key: "a", Values: []string{"3"}}})},
+			want: "a=3&b=b",
+		},
+		{
+			name: "add",
+			ops:  []*pb.QueryOperation{op(&pb.QueryOperation_Add{Add: &pb.Header{Key: "b", Values: []string{"c"}}})},
+			want: "a=1&a=2&b=b&b=c",
+		},
+		{
+			name: "remove",
+			ops:  []*pb.QueryOperation{op(&pb.QueryOperation_Remove{Remove: "a"})},
+			want: "b=b",
+		},
+		{
+			name: "rename",
+			ops:  []*pb.QueryOperation{op(&pb.QueryOperation_Rename{Rename: &pb.Rename{From: "a", To: "c"}})},
+			want: "b=b&c=1&c=2",
+		},
+		{
+			// Query params are case sensitive, unlike headers.
+			name: "case sensitive",
+			ops:  []*pb.QueryOperation{op(&pb.QueryOperation_Remove{Remove: "A"})},
+			want: "a=1&a=2&b=b",
+		},
+	}
+	for _, tt := range tests {
+		q := url.Values{"a": {"1", "2"}, "b": {"b"}}
+		Query(q, tt.ops)
+		if got := q.Encode(); got != tt.want {
+			t.Errorf("%s: Query() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBody(t *testing.T) {
+	set := func(path, value string) *pb.BodyOperation {
+		return &pb.BodyOperation{Operation: &pb.BodyOperation_Set{Set: &pb.SetField{Path: path, Value: value}}}
+	}
+	del := func(path string) *pb.BodyOperation {
+		return &pb.BodyOperation{Operation: &pb.BodyOperation_Delete{Delete: path}}
+	}
+	move := func(from, to string) *pb.BodyOperation {
+		return &pb.BodyOperation{Operation: &pb.BodyOperation_Move{Move: &pb.Rename{From: from, To: to}}}
+	}
+	rename := func(from, to string) *pb.BodyOperation {
+		return &pb.BodyOperation{Operation: &pb.BodyOperation_Rename{Rename: &pb.Rename{From: from, To: to}}}
+	}
+	const body = `{"user":{"id":12345678901234567890,"name":"a"},"items":[{"sku":"x"},{"sku":"y"}],"v":1}`
+	tests := []struct {
+		name    string
+		body    string
+		ops     []*pb.BodyOperation
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "no operations",
+			body: `not JSON`,
+			want: `not JSON`,
+		},
+		{
+			name: "set",
+			body: body,
+			ops:  []*pb.BodyOperation{set("v", `2`)},
+			want: `{"items":[{"sku":"x"},{"sku":"y"}],"user":{"id":12345678901234567890,"name":"a"},"v":2}`,
+		},
+		{
+			name: "set creates the objects on the path",
+			body: `{}`,
+			ops:  []*pb.BodyOperation{set("a.b.c", `{"d":true}`)},
+			want: `{"a":{"b":{"c":{"d":true}}}}`,
+		},
+		{
+			name: "set replaces scalars on the path",
+			body: `{"a":1}`,
+			ops:  []*pb.BodyOperation{set("a.b", `"x"`)},
+			want: `{"a":{"b":"x"}}`,
+		},
+		{
+			name: "set an array element",
+			body: body,
+			ops:  []*pb.BodyOperation{set("items.1.sku", `"z"`)},
+			want: `{"items":[{"sku":"x"},{"sku":"z"}],"user":{"id":12345678901234567890,"name":"a"},"v":1}`,
+		},
+		{
+			name:    "set out of range",
+			body:    body,
+			ops:     []*pb.BodyOperation{set("items.2.sku", `"z"`)},
+			wantErr: true,
+		},
+		{
+			name:    "set an invalid value",
+			body:    body,
+			ops:     []*pb.BodyOperation{set("v", `{`)},
+			wantErr: true,
+		},
+		{
+			name: "delete",
+			body: body,
+			ops:  []*pb.BodyOperation{del("user.name"), del("items.0"), del("missing.field")},
+			want: `{"items":[{"sku":"y"}],"user":{"id":12345678901234567890},"v":1}`,
+		},
+		{
+			name: "move",
+			body: body,
+			ops:  []*pb.BodyOperation{move("user.id", "user_id")},
+			want: `{"items":[{"sku":"x"},{"sku":"y"}],"user":{"name":"a"},"user_id":12345678901234567890,"v":1}`,
+		},
+		{
+			name: "move a missing field",
+			body: `{"a":1}`,
+			ops:  []*pb.BodyOperation{move("b", "c")},
+			want: `{"a":1}`,
+		},
+		{
+			name: "rename",
+			body: body,
+			ops:  []*pb.BodyOperation{rename("user.name", "login")},
+			want: `{"items":[{"sku":"x"},{"sku":"y"}],"user":{"id":12345678901234567890,"login":"a"},"v":1}`,
+		},
+		{
+			name:    "not JSON",
+			body:    `not JSON`,
+			ops:     []*pb.BodyOperation{del("a")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		got, err := Body([]byte(tt.body), tt.ops)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: Body() = %s, want an error", tt.name, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: Body() = %v", tt.name, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("%s: Body() = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		t    *pb.Transform
+		ok   bool
+	}{
+		{name: "nil", ok: true},
+		{
+			name: "valid",
+			t: &pb.Transform{
+				Headers: []*pb.HeaderOperation{setHeader("X-A", "1"), renameHeader("X-B", "X-C")},
+				Body:    []*pb.BodyOperation{{Operation: &pb.BodyOperation_Set{Set: &pb.SetField{Path: "a.b", Value: `1`}}}},
+			},
+			ok: true,
+		},
+		{name: "header without a name", t: &pb.Transform{Headers: []*pb.HeaderOperation{setHeader("", "1")}}},
+		{name: "missing operation", t: &pb.Transform{Headers: []*pb.HeaderOperation{{}}}},
+		{name: "rename without a target", t: &pb.Transform{Headers: []*pb.HeaderOperation{renameHeader("X-A", "")}}},
+		{
+			name: "query without a name",
+			t:    &pb.Transform{Query: []*pb.QueryOperation{{Operation: &pb.QueryOperation_Remove{Remove: ""}}}},
+		},
+		{
+			name: "empty path segment",
+			t:    &pb.Transform{Body: []*pb.BodyOperation{{Operation: &pb.BodyOperation_Delete{Delete: "a..b"}}}},
+		},
+		{
+			name: "value isn't JSON",
+			t:    &pb.Transform{Body: []*pb.BodyOperation{{Operation: &pb.BodyOperation_Set{Set: &pb.SetField{Path: "a", Value: `x`}}}}},
+		},
+		{
+			name: "rename to a path",
+			t:    &pb.Transform{Body: []*pb.BodyOperation{{Operation: &pb.BodyOperation_Rename{Rename: &pb.Rename{From: "a", To: "b.c"}}}}},
+		},
+	}
+	for _, tt := range tests {
+		if err := Validate(tt.t); (err == nil) != tt.ok {
+			t.Errorf("%s: Validate() = %v, want ok = %v", tt.name, err, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package fanout
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/gorilla/mux"
+)
+
+// received is a request received by an endpoint.
+type received struct {
+	header        http.Header
+	query         string
+	contentLength int64
+	body          string
+}
+
+func TestTransform(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests = make(map[string]received)
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		requests[r.URL.Path] = received{
+			header:        r.Header.Clone(),
+			query:         r.URL.RawQuery,
+			contentLength: r.ContentLength,
+			body:          string(body),
+		}
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	endpoint := func(name string, transform *pb.Transform) *pb.Endpoint {
+		return &pb.Endpoint{Name: name, Primary: transform == nil, Transform: transform, Destination: &pb.Endpoint_HttpEndpoint{
+			HttpEndpoint: &pb.HTTPEndpoint{Url: srv.URL + "/" + name},
+		}}
+	}
+	transform := &pb.Transform{
+		Headers: []*pb.HeaderOperation{
+			{Operation: &pb.HeaderOperation_Set{Set: &pb.Header{Key: "X-Env", Values: []string{"shadow"}}}},
+			{Operation: &pb.HeaderOperation_Remove{Remove: "X-User"}},
+		},
+		Query: []*pb.QueryOperation{
+			{Operation: &pb.QueryOperation_Set{Set: &pb.Header{Key: "v", Values: []string{"2"}}}},
+		},
+		Body: []*pb.BodyOperation{
+			{Operation: &pb.BodyOperation_Delete{Delete: "user"}},
+			{Operation: &pb.BodyOperation_Set{Set: &pb.SetField{Path: "shadow", Value: `true`}}},
+		},
+	}
+	tests := []struct {
+		name string
+		body string
+		// want is the request received by the transformed
+		// endpoint, nil if it isn't called.
+		want *received
+	}{
+		{
+			name: "transformed",
+			body: `{"id":1,"user":"alice"}`,
+			want: &received{query: "v=2", body: `{"id":1,"shadow":true}`},
+		},
+		{
+			// Body operations don't apply to empty bodies.
+			name: "empty body",
+			want: &received{query: "v=2"},
+		},
+		{
+			// The endpoint fails, the primary endpoint is served.
+			name: "body isn't JSON",
+			body: `id=1`,
+		},
+	}
+
+	fanouts := make(map[string]*pb.GetFanoutResponse)
+	run := testRuns.Add(1)
+	name := func(i int) string {
+		return fmt.Sprintf("transform-%d-%d", run, i)
+	}
+	for i := range tests {
+		fanouts[name(i)] = &pb.GetFanoutResponse{Endpoints: []*pb.Endpoint{
+			endpoint("plain", nil),
+			endpoint("transformed", transform),
+		}}
+	}
+	r := mux.NewRouter()
+	r.Handle("/fanout/{name}", newTestHandler(fanouts))
+	front := httptest.NewServer(r)
+	defer front.Close()
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mu.Lock()
+			requests = make(map[string]received)
+			mu.Unlock()
+
+			req, err := http.NewRequest(http.MethodPost, front.URL+"/fanout/"+name(i)+"?v=1", strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("X-Env", "prod")
+			req.Header.Set("X-User", "alice")
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			// The primary endpoint receives the inbound request unchanged.
+			plain, ok := requests["/plain"]
+			if !ok {
+				t.Fatal("the primary endpoint isn't called")
+			}
+			if plain.body != tt.body || plain.contentLength != int64(len(tt.body)) || plain.query != "v=1" {
+				t.Errorf("primary endpoint received %q with Content-Length %d and query %q, want %q", plain.body, plain.contentLength, plain.query, tt.body)
+			}
+			if got := plain.header.Get("X-Env"); got != "prod" {
+				t.Errorf("primary endpoint received X-Env %q, want prod", got)
+			}
+			if got := plain.header.Get("X-User"); got != "alice" {
+				t.Errorf("primary endpoint received X-User %q, want alice", got)
+			}
+
+			got, ok := requests["/transformed"]
+			if tt.want == nil {
+				if ok {
+					t.Errorf("the transformed endpoint received %q, want not called", got.body)
+				}
+				return
+			}
+			if !ok {
+				t.Fatal("the transformed endpoint isn't called")
+			}
+			if got.body != tt.want.body || got.query != tt.want.query {
+				t.Errorf("transformed endpoint received %q with query %q, want %q with query %q", got.body, got.query, tt.want.body, tt.want.query)
+			}
+			// Content-Length is the length of the transformed body.
+			if got.contentLength != int64(len(tt.want.body)) {
+				t.Errorf("transformed endpoint received Content-Length %d, want %d", got.contentLength, len(tt.want.body))
+			}
+			if v := got.header.Values("X-Env"); !reflect.DeepEqual(v, []string{"shadow"}) {
+				t.Errorf("transformed endpoint received X-Env %q, want [shadow]", v)
+			}
+			if v := got.header.Values("X-User"); v != nil {
+				t.Errorf("transformed endpoint received X-User %q, want removed", v)
+			}
+		})
+	}
+}
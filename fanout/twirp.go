@@ -0,0 +1,125 @@
+package fanout
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/twitchtv/twirp"
+)
+
+func (worker *Worker) doTwirp(r *http.Request, fanout string, endpoint *pb.Endpoint, twirpEndpoint *pb.TwirpEndpoint) (*workerResponse, error) {
+	proxyReq, err := worker.newTwirpRequest(r, fanout, twirpEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := worker.clientCache.HTTPClient(fanout, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a client: %w", err)
+	}
+
+	resp, err := client.Do(proxyReq)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusOK {
+		return &workerResponse{
+			code:   resp.StatusCode,
+			header: resp.Header,
+			body:   resp.Body,
+			size:   resp.ContentLength,
+		}, nil
+	}
+
+	defer resp.Body.Close()
+	return &workerResponse{
+		code:   resp.StatusCode,
+		header: resp.Header,
+		size:   resp.ContentLength,
+		twerr:  twirpErrorFromResponse(resp),
+	}, nil
+}
+
+// newTwirpRequest creates the request to a Twirp endpoint.
+func (worker *Worker) newTwirpRequest(r *http.Request, fanout string, twirpEndpoint *pb.TwirpEndpoint) (*http.Request, error) {
+	// Twirp methods are always called with POST.
+	proxyReq, err := http.NewRequestWithContext(r.Context(), http.MethodPost, twirpURL(twirpEndpoint), worker.newBodyReader(r))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a request: %w", err)
+	}
+	worker.setContentLength(proxyReq, r)
+	setHeaders(proxyReq, r, fanout, twirpEndpoint.Header)
+	if err := worker.handler.addSecretHeaders(r.Context(), proxyReq.Header, twirpEndpoint.Header); err != nil {
+		return nil, err
+	}
+
+	contentType := "application/protobuf"
+	if twirpEndpoint.ContentType == pb.TwirpContentType_TWIRP_CONTENT_TYPE_JSON {
+		contentType = "application/json"
+	}
+	proxyReq.Header.Set("Content-Type", contentType)
+	proxyReq.Header.Set("Accept", contentType)
+	proxyReq.Header.Set("Twirp-Version", "v8.1.3")
+	return proxyReq, nil
+}
+
+// twirpURL returns the URL of the endpoint's Twirp method.
+func twirpURL(twirpEndpoint *pb.TwirpEndpoint) string {
+	prefix := twirpEndpoint.PathPrefix
+	if prefix == "" {
+		prefix = "/twirp"
+	}
+	return strings.TrimSuffix(twirpEndpoint.BaseUrl, "/") +
+		"/" + strings.Trim(prefix, "/") +
+		"/" + twirpEndpoint.Service +
+		"/" + twirpEndpoint.Method
+}
+
+// twirpErrorFromResponse parses the Twirp error in a non-200 response.
+// Responses that are not Twirp errors, e.g. responses from a proxy in
+// between, are converted to Twirp errors based on their status code.
+func twirpErrorFromResponse(resp *http.Response) twirp.Error {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return twirp.InternalErrorWith(err)
+	}
+
+	var v struct {
+		Code string            `json:"code"`
+		Msg  string            `json:"msg"`
+		Meta map[string]string `json:"meta"`
+	}
+	if err := json.Unmarshal(body, &v); err == nil && twirp.IsValidErrorCode(twirp.ErrorCode(v.Code)) {
+		twerr := twirp.NewError(twirp.ErrorCode(v.Code), v.Msg)
+		for k, val := range v.Meta {
+			twerr = twerr.WithMeta(k, val)
+		}
+		return twerr
+	}
+
+	// Not a Twirp error, map the status code as Twirp clients do.
+	var code twirp.ErrorCode
+	switch resp.StatusCode {
+	case http.StatusBadRequest:
+		code = twirp.Internal
+	case http.StatusUnauthorized:
+		code = twirp.Unauthenticated
+	case http.StatusForbidden:
+		code = twirp.PermissionDenied
+	case http.StatusNotFound:
+		code = twirp.BadRoute
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		code = twirp.Unavailable
+	default:
+		code = twirp.Unknown
+	}
+	twerr := twirp.NewError(code, "Error from intermediary with HTTP status code "+strconv.Itoa(resp.StatusCode))
+	twerr = twerr.WithMeta("http_error_from_intermediary", "true")
+	twerr = twerr.WithMeta("status_code", strconv.Itoa(resp.StatusCode))
+	return twerr.WithMeta("body", string(body))
+}
@@ -0,0 +1,51 @@
+package fanout
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/dfanout/dfanout/fanout/urltemplate"
+	pb "github.com/dfanout/dfanout/proto"
+	"github.com/gorilla/mux"
+)
+
+// restPath returns the inbound path after the fanout name.
+func restPath(r *http.Request) string {
+	return mux.Vars(r)["rest"]
+}
+
+// httpURL returns the URL to send the request to. It expands the
+// endpoint's URL template, appends the inbound path if the endpoint
+// forwards it and adds the inbound query params.
+func httpURL(r *http.Request, httpEndpoint *pb.HTTPEndpoint) (string, error) {
+	rest := restPath(r)
+	rawURL, err := urltemplate.Expand(httpEndpoint.Url, urltemplate.NewData(r, rest))
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL %q: %w", rawURL, err)
+	}
+	if rest = strings.Trim(rest, "/"); httpEndpoint.ForwardPath && rest != "" {
+		segments := strings.Split(rest, "/")
+		for i, s := range segments {
+			segments[i] = url.PathEscape(s)
+		}
+		u = u.JoinPath(segments...)
+	}
+	if len(r.URL.RawQuery) > 0 {
+		query := u.Query()
+		for k, vv := range r.URL.Query() {
+			if query.Has(k) {
+				// The endpoint's URL sets the param.
+				continue
+			}
+			query[k] = vv
+		}
+		u.RawQuery = query.Encode()
+	}
+	return u.String(), nil
+}
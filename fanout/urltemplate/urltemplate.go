@@ -0,0 +1,235 @@
+// Package urltemplate expands endpoint URL templates
+// with the attributes of the inbound request.
+//
+// Templates use the text/template syntax with a Data value, e.g.
+//
+//	https://api/v2/items/{{index .Segments 0}}?user={{.Header.Get "X-User"}}
+//
+// Values are escaped for their position in the URL: values in the
+// host are query escaped, values in the path are escaped as a single
+// path segment, except Data.Path whose segments are escaped separately,
+// and values in the query and the fragment are query escaped. Path
+// segments can't be "." or "..". Values piped to the pathescape or
+// queryescape functions are escaped only by them, and values piped to
+// the raw function are not escaped.
+package urltemplate
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"text/template"
+	"text/template/parse"
+)
+
+// Path is a slash separated path. Its segments
+// are escaped separately in the path of a URL.
+type Path string
+
+// Data is the data templates are executed with.
+type Data struct {
+	// Path is the part of the inbound path after the fanout
+	// name, e.g. "items/42" for "/fanout/name/items/42".
+	Path Path
+
+	// Segments are the slash separated segments of Path.
+	Segments []string
+
+	Method string
+	Query  url.Values
+	Header http.Header
+}
+
+// NewData returns the template data of the inbound request.
+func NewData(r *http.Request, path string) *Data {
+	path = strings.Trim(path, "/")
+	var segments []string
+	if path != "" {
+		segments = strings.Split(path, "/")
+	}
+	return &Data{
+		Path:     Path(path),
+		Segments: segments,
+		Method:   r.Method,
+		Query:    r.URL.Query(),
+		Header:   r.Header,
+	}
+}
+
+// Names of the escapers added to the templates' actions.
+const (
+	hostEscaper  = "_urltemplate_hostescape"
+	pathEscaper  = "_urltemplate_pathescape"
+	queryEscaper = "_urltemplate_queryescape"
+)
+
+var funcs = template.FuncMap{
+	"pathescape":  pathEscape,
+	"queryescape": queryEscape,
+	"raw":         raw,
+	hostEscaper:   queryEscape,
+	pathEscaper:   pathEscape,
+	queryEscaper:  queryEscape,
+}
+
+// escapers are the functions whose output is not escaped again.
+var escapers = map[string]bool{
+	"pathescape":  true,
+	"queryescape": true,
+	"raw":         true,
+	hostEscaper:   true,
+	pathEscaper:   true,
+	queryEscaper:  true,
+}
+
+// pathEscape escapes the value as a path segment, or as
+// separately escaped path segments if the value is a Path.
+func pathEscape(v any) (string, error) {
+	s := fmt.Sprint(v)
+	segments := []string{s}
+	if _, ok := v.(Path); ok {
+		segments = strings.Split(s, "/")
+	}
+	for i, segment := range segments {
+		if segment == "." || segment == ".." {
+			return "", fmt.Errorf("path segment can't be %q", segment)
+		}
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/"), nil
+}
+
+func queryEscape(v any) string {
+	return url.QueryEscape(fmt.Sprint(v))
+}
+
+func raw(v any) string {
+	return fmt.Sprint(v)
+}
+
+// IsTemplate reports whether s contains template actions.
+func IsTemplate(s string) bool {
+	return strings.Contains(s, "{{")
+}
+
+// Parse parses the URL template, and escapes its actions
+// for their position in the URL.
+func Parse(s string) (*template.Template, error) {
+	t, err := template.New("url").Funcs(funcs).Option("missingkey=error").Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	if t.Tree != nil {
+		escapeList(t.Tree.Root, stateScheme, true)
+	}
+	return t, nil
+}
+
+var cache sync.Map // string -> *template.Template
+
+// Expand expands the URL template with data. Parsed
+// templates are cached. If s is not a template,
+// it is returned as is.
+func Expand(s string, data *Data) (string, error) {
+	if !IsTemplate(s) {
+		return s, nil
+	}
+	var t *template.Template
+	if v, ok := cache.Load(s); ok {
+		t = v.(*template.Template)
+	} else {
+		var err error
+		if t, err = Parse(s); err != nil {
+			return "", err
+		}
+		cache.Store(s, t)
+	}
+	var b strings.Builder
+	if err := t.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("failed to expand the URL template: %w", err)
+	}
+	return b.String(), nil
+}
+
+// state is the position in the URL, in the order of the URL's parts.
+type state int
+
+const (
+	stateScheme state = iota
+	stateHost
+	statePath
+	stateQuery // the query or the fragment
+)
+
+// next returns the position after the text.
+func (s state) next(text string) state {
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; {
+		case s == stateScheme && strings.HasPrefix(text[i:], "://"):
+			s = stateHost
+			i += 2
+		case s < statePath && c == '/':
+			s = statePath
+		case s < stateQuery && (c == '?' || c == '#'):
+			s = stateQuery
+		}
+	}
+	return s
+}
+
+func (s state) escaper() string {
+	switch s {
+	case statePath:
+		return pathEscaper
+	case stateQuery:
+		return queryEscaper
+	default:
+		return hostEscaper
+	}
+}
+
+// escapeList adds escapers to the actions of the list for their
+// position, if mutate is set, and returns the position after the list.
+// If branches end in different positions, the later one is assumed.
+func escapeList(list *parse.ListNode, s state, mutate bool) state {
+	if list == nil {
+		return s
+	}
+	for _, n := range list.Nodes {
+		switch n := n.(type) {
+		case *parse.TextNode:
+			s = s.next(string(n.Text))
+		case *parse.ActionNode:
+			if mutate && len(n.Pipe.Decl) == 0 {
+				escapePipe(n.Pipe, s)
+			}
+		case *parse.IfNode:
+			s = max(escapeList(n.List, s, mutate), escapeList(n.ElseList, s, mutate))
+		case *parse.WithNode:
+			s = max(escapeList(n.List, s, mutate), escapeList(n.ElseList, s, mutate))
+		case *parse.RangeNode:
+			// The body may follow itself.
+			entry := max(s, escapeList(n.List, s, false))
+			s = max(escapeList(n.List, entry, mutate), escapeList(n.ElseList, s, mutate))
+		}
+	}
+	return s
+}
+
+// escapePipe pipes the output of the pipeline to the
+// escaper of the position, unless it is escaped already.
+func escapePipe(p *parse.PipeNode, s state) {
+	if len(p.Cmds) > 0 {
+		last := p.Cmds[len(p.Cmds)-1]
+		if id, ok := last.Args[0].(*parse.IdentifierNode); ok && escapers[id.Ident] {
+			return
+		}
+	}
+	p.Cmds = append(p.Cmds, &parse.CommandNode{
+		NodeType: parse.NodeCommand,
+		Pos:      p.Pos,
+		Args:     []parse.Node{parse.NewIdentifier(s.escaper()).SetPos(p.Pos)},
+	})
+}
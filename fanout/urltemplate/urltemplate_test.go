@@ -0,0 +1,114 @@
+package urltemplate
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExpand(t *testing.T) {
+	r := httptest.NewRequest("GET", "/fanout/f/items/a%20b?q=x%26y", nil)
+	r.Header.Set("X-User", "a&admin=true")
+	r.Header.Set("X-Dir", "../admin")
+	r.Header.Set("X-Dot", "..")
+	r.Header.Set("X-Region", "eu@evil.com")
+	data := NewData(r, "items/a b/")
+
+	tests := []struct {
+		template string
+		want     string
+		wantErr  bool
+	}{
+		{
+			template: "https://api/v1",
+			want:     "https://api/v1",
+		},
+		{
+			template: "https://api/{{.Path}}",
+			want:     "https://api/items/a%20b",
+		},
+		{
+			template: "https://api/x/{{index .Segments 1}}",
+			want:     "https://api/x/a%20b",
+		},
+		{
+			template: `https://api/x?user={{.Header.Get "X-User"}}`,
+			want:     "https://api/x?user=a%26admin%3Dtrue",
+		},
+		{
+			template: `https://api/x/{{.Header.Get "X-Dir"}}`,
+			want:     "https://api/x/..%2Fadmin",
+		},
+		{
+			template: `https://api/x/{{.Header.Get "X-Dot"}}`,
+			wantErr:  true,
+		},
+		{
+			template: `https://api/x#{{.Header.Get "X-User"}}`,
+			want:     "https://api/x#a%26admin%3Dtrue",
+		},
+		{
+			template: `https://{{.Header.Get "X-Region"}}.api/x`,
+			want:     "https://eu%40evil.com.api/x",
+		},
+		{
+			template: `https://api/x?q={{.Query.Get "q"}}&p={{.Path}}`,
+			want:     "https://api/x?q=x%26y&p=items%2Fa+b",
+		},
+		{
+			// Explicit escapers aren't escaped again.
+			template: `https://api/{{.Header.Get "X-User" | queryescape}}`,
+			want:     "https://api/a%26admin%3Dtrue",
+		},
+		{
+			template: `https://api/x?{{.Header.Get "X-User" | raw}}`,
+			want:     "https://api/x?a&admin=true",
+		},
+		{
+			template: "https://api{{range .Segments}}/{{.}}{{end}}",
+			want:     "https://api/items/a%20b",
+		},
+		{
+			template: `https://api/{{if .Query.Has "q"}}search?q={{end}}{{.Method}}`,
+			want:     "https://api/search?q=GET",
+		},
+		{
+			template: "https://api/{{$m := .Method}}{{$m}}",
+			want:     "https://api/GET",
+		},
+		{
+			template: "https://api/{{len .Segments}}",
+			want:     "https://api/2",
+		},
+		{
+			template: "https://api/{{.Missing}}",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		got, err := Expand(tt.template, data)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Expand(%q) error = %v, want error: %v", tt.template, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Expand(%q) = %q, want %q", tt.template, got, tt.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		template string
+		wantErr  bool
+	}{
+		{template: "https://api/{{.Path}}"},
+		{template: `https://api/{{.Header.Get "X" | raw}}`},
+		{template: "https://api/{{.Path", wantErr: true},
+		{template: "https://api/{{unknown .Path}}", wantErr: true},
+	}
+	for _, tt := range tests {
+		if _, err := Parse(tt.template); (err != nil) != tt.wantErr {
+			t.Errorf("Parse(%q) error = %v, want error: %v", tt.template, err, tt.wantErr)
+		}
+	}
+}
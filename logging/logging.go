@@ -0,0 +1,143 @@
+// Package logging configures structured logging and defines
+// the log fields shared by the fanout handler and the admin service.
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	mathrand "math/rand"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Log field keys.
+const (
+	RequestID = "request_id"
+	Fanout    = "fanout"
+	Endpoint  = "endpoint"
+	Primary   = "primary"
+	Method    = "method"
+	URL       = "url"
+	Status    = "status"
+	Bytes     = "bytes"
+	Duration  = "duration_ms"
+	Attempts  = "attempts"
+	Outcome   = "outcome"
+	Error     = "error"
+	Subject   = "subject"
+)
+
+// RequestIDHeader is the header that carries the request ID.
+// Inbound requests without the header are assigned a new ID.
+const RequestIDHeader = "X-Request-Id"
+
+type Config struct {
+	// Level is the minimum level to log; debug, info, warn or error.
+	Level string
+
+	// SampleRate is the fraction of requests, between 0 and 1, that
+	// log records below the warn level. Warnings and errors are
+	// always logged.
+	SampleRate float64
+}
+
+var sampleRate = 1.0
+
+// Setup sets the default logger to a JSON logger that writes to w.
+func Setup(w io.Writer, c Config) error {
+	var level slog.Level
+	if c.Level != "" {
+		if err := level.UnmarshalText([]byte(c.Level)); err != nil {
+			return fmt.Errorf("invalid log level %q", c.Level)
+		}
+	}
+	if c.SampleRate < 0 || c.SampleRate > 1 {
+		return fmt.Errorf("log sample rate %v is not between 0 and 1", c.SampleRate)
+	}
+	sampleRate = c.SampleRate
+	slog.SetDefault(slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level})))
+	return nil
+}
+
+type loggerKey struct{}
+
+// FromContext returns the request's logger, or the
+// default logger if the context has no request.
+func FromContext(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return l
+	}
+	return slog.Default()
+}
+
+// Request assigns a request ID to r, unless it already has one, and
+// returns r with a logger that logs the ID. The ID is also set on the
+// response headers and forwarded to the endpoints with the inbound
+// headers. Whether the request's records below the warn
+// level are logged is decided once for the request.
+func Request(w http.ResponseWriter, r *http.Request) *http.Request {
+	if _, ok := r.Context().Value(loggerKey{}).(*slog.Logger); ok {
+		return r
+	}
+	id := strings.TrimSpace(r.Header.Get(RequestIDHeader))
+	if id == "" {
+		id = newRequestID()
+		r.Header.Set(RequestIDHeader, id)
+	}
+	w.Header().Set(RequestIDHeader, id)
+
+	h := slog.Default().Handler()
+	if !sampled() {
+		h = &minLevelHandler{Handler: h, level: slog.LevelWarn}
+	}
+	l := slog.New(h).With(RequestID, id)
+	return r.WithContext(context.WithValue(r.Context(), loggerKey{}, l))
+}
+
+// Middleware calls Request for each request.
+func Middleware(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, Request(w, r))
+	})
+}
+
+// Took returns the Duration field for d, in milliseconds.
+func Took(d time.Duration) slog.Attr {
+	return slog.Float64(Duration, math.Round(float64(d.Microseconds()))/1e3)
+}
+
+func sampled() bool {
+	return sampleRate >= 1 || mathrand.Float64() < sampleRate
+}
+
+func newRequestID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b[:])
+}
+
+// minLevelHandler drops records below level.
+type minLevelHandler struct {
+	slog.Handler
+	level slog.Level
+}
+
+func (h *minLevelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.level && h.Handler.Enabled(ctx, level)
+}
+
+func (h *minLevelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &minLevelHandler{Handler: h.Handler.WithAttrs(attrs), level: h.level}
+}
+
+func (h *minLevelHandler) WithGroup(name string) slog.Handler {
+	return &minLevelHandler{Handler: h.Handler.WithGroup(name), level: h.level}
+}
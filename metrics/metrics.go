@@ -0,0 +1,177 @@
+// Package metrics contains the Prometheus metrics
+// reported by dfanout.
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/mailgun/groupcache"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "dfanout"
+
+var (
+	InboundRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "inbound_requests_total",
+		Help:      "Number of requests served by fanouts.",
+	}, []string{"fanout", "code"})
+
+	InboundLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "inbound_request_duration_seconds",
+		Help:      "Latency of requests served by fanouts.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"fanout"})
+
+	InboundUnauthenticated = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "inbound_unauthenticated_total",
+		Help:      "Number of requests to fanouts rejected by their inbound auth.",
+	}, []string{"fanout"})
+
+	InboundRateLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "inbound_rate_limited_total",
+		Help:      "Number of requests to fanouts rejected by their rate limits.",
+	}, []string{"fanout"})
+
+	InboundInFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "inbound_requests_in_flight",
+		Help:      "Number of requests being served by fanouts.",
+	}, []string{"fanout"})
+
+	OutboundAttempts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "outbound_attempts_total",
+		Help:      "Number of attempts made to endpoints by status class, e.g. 2xx, or error.",
+	}, []string{"fanout", "endpoint", "status_class"})
+
+	OutboundErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "outbound_errors_total",
+		Help:      "Number of requests to endpoints that failed after all attempts.",
+	}, []string{"fanout", "endpoint"})
+
+	OutboundLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "outbound_attempt_duration_seconds",
+		Help:      "Latency of attempts made to endpoints.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"fanout", "endpoint"})
+
+	OutboundInFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "outbound_requests_in_flight",
+		Help:      "Number of requests being made to endpoints.",
+	}, []string{"fanout", "endpoint"})
+
+	BackgroundDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "background_dropped_total",
+		Help:      "Number of background requests to endpoints dropped because the background queue was full.",
+	}, []string{"fanout", "endpoint"})
+
+	OutboxEnqueued = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "outbox_enqueued_total",
+		Help:      "Number of failed requests to durable endpoints persisted to the outbox.",
+	}, []string{"fanout", "endpoint"})
+
+	OutboxDeliveries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "outbox_deliveries_total",
+		Help:      "Number of delivery attempts from the outbox by outcome; delivered, retried or dead.",
+	}, []string{"fanout", "endpoint", "outcome"})
+
+	CircuitBreakerState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "circuit_breaker_state",
+		Help:      "State of the circuit breakers of endpoints; 0 is closed, 1 is open and 2 is half-open.",
+	}, []string{"fanout", "endpoint"})
+
+	CircuitBreakerRejected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "circuit_breaker_rejected_total",
+		Help:      "Number of requests to endpoints failed immediately by their circuit breakers.",
+	}, []string{"fanout", "endpoint"})
+
+	OutboundLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "outbound_limited_total",
+		Help:      "Number of requests to endpoints shed or timed out waiting by a concurrency limit; global, fanout or endpoint.",
+	}, []string{"fanout", "endpoint", "limit"})
+
+	OutboundRateLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "outbound_rate_limited_total",
+		Help:      "Number of requests to endpoints over their outbound rate limits by action; dropped, deferred, failed or waited.",
+	}, []string{"fanout", "endpoint", "action"})
+
+	Hedges = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "hedged_requests_total",
+		Help:      "Number of hedged requests sent to endpoints.",
+	}, []string{"fanout", "endpoint"})
+
+	HedgeWins = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "hedge_wins_total",
+		Help:      "Number of hedged fans won by each endpoint, including the primary.",
+	}, []string{"fanout", "endpoint"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		InboundRequests,
+		InboundLatency,
+		InboundInFlight,
+		InboundRateLimited,
+		InboundUnauthenticated,
+		OutboundAttempts,
+		OutboundErrors,
+		OutboundLatency,
+		OutboundInFlight,
+		BackgroundDropped,
+		OutboxEnqueued,
+		OutboxDeliveries,
+		CircuitBreakerState,
+		CircuitBreakerRejected,
+		OutboundLimited,
+		OutboundRateLimited,
+		Hedges,
+		HedgeWins,
+	)
+}
+
+// StatusClass returns the class of an HTTP status code, e.g. "2xx".
+func StatusClass(code int) string {
+	if code < 100 || code > 599 {
+		return "unknown"
+	}
+	return strconv.Itoa(code/100) + "xx"
+}
+
+// RegisterCache registers the counters of a groupcache group.
+func RegisterCache(name string, stats *groupcache.Stats) {
+	counter := func(metric, help string, v *groupcache.AtomicInt) prometheus.Collector {
+		return prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        metric,
+			Help:        help,
+			ConstLabels: prometheus.Labels{"cache": name},
+		}, func() float64 {
+			return float64(v.Get())
+		})
+	}
+	prometheus.MustRegister(
+		counter("cache_gets_total", "Number of cache lookups.", &stats.Gets),
+		counter("cache_hits_total", "Number of cache lookups served from the cache.", &stats.CacheHits),
+		counter("cache_misses_total", "Number of cache lookups not served from the cache.", &stats.Loads),
+		counter("cache_peer_loads_total", "Number of cache misses loaded from peers.", &stats.PeerLoads),
+		counter("cache_local_loads_total", "Number of cache misses loaded locally.", &stats.LocalLoads),
+		counter("cache_local_load_errors_total", "Number of failed local loads.", &stats.LocalLoadErrs),
+	)
+}
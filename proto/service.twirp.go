@@ -1,4 +1,4 @@
-// Code generated by protoc-gen-twirp v8.1.2, DO NOT EDIT.
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
 // source: proto/service.proto
 
 package dfanout
@@ -6,7 +6,7 @@ package dfanout
 import context "context"
 import fmt "fmt"
 import http "net/http"
-import ioutil "io/ioutil"
+import io "io"
 import json "encoding/json"
 import strconv "strconv"
 import strings "strings"
@@ -18,7 +18,6 @@ import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
 
 import bytes "bytes"
 import errors "errors"
-import io "io"
 import path "path"
 import url "net/url"
 
@@ -40,6 +39,42 @@ type AdminService interface {
 	UpdateFanout(context.Context, *UpdateFanoutRequest) (*UpdateFanoutRequest, error)
 
 	DeleteFanout(context.Context, *DeleteFanoutRequest) (*DeleteFanoutResponse, error)
+
+	// GetMismatches returns the results of comparing the responses of
+	// non-primary endpoints to the primary responses on all the nodes.
+	// Nodes add their results every -mismatches-flush-interval.
+	GetMismatches(context.Context, *GetMismatchesRequest) (*GetMismatchesResponse, error)
+
+	// SetEndpointSampling changes the sampling of an endpoint
+	// without updating the rest of the fanout.
+	SetEndpointSampling(context.Context, *SetEndpointSamplingRequest) (*SetEndpointSamplingResponse, error)
+
+	// SetRateLimit changes the inbound rate limit of a fanout
+	// without updating the rest of the fanout.
+	SetRateLimit(context.Context, *SetRateLimitRequest) (*SetRateLimitResponse, error)
+
+	// ListDeadLetters lists the requests to durable endpoints that
+	// couldn't be delivered, without their bodies.
+	ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error)
+
+	// GetDeadLetter returns a dead letter with its headers and body.
+	GetDeadLetter(context.Context, *GetDeadLetterRequest) (*GetDeadLetterResponse, error)
+
+	// ReplayDeadLetters queues dead letters to be delivered again.
+	ReplayDeadLetters(context.Context, *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error)
+
+	// PurgeDeadLetters deletes dead letters.
+	PurgeDeadLetters(context.Context, *PurgeDeadLettersRequest) (*PurgeDeadLettersResponse, error)
+
+	// SetSecret creates or rotates a secret of the secrets table,
+	// referenced as "db:<name>". Secret values are never returned.
+	SetSecret(context.Context, *SetSecretRequest) (*SetSecretResponse, error)
+
+	// DeleteSecret deletes a secret of the secrets table.
+	DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error)
+
+	// ListSecrets lists the secrets of the secrets table, without their values.
+	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
 }
 
 // ============================
@@ -48,7 +83,7 @@ type AdminService interface {
 
 type adminServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [4]string
+	urls        [14]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -65,7 +100,7 @@ func NewAdminServiceProtobufClient(baseURL string, client HTTPClient, opts ...tw
 		o(&clientOpts)
 	}
 
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
 	literalURLs := false
 	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
 	var pathPrefix string
@@ -76,11 +111,21 @@ func NewAdminServiceProtobufClient(baseURL string, client HTTPClient, opts ...tw
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "dfanout", "AdminService")
-	urls := [4]string{
+	urls := [14]string{
 		serviceURL + "GetFanout",
 		serviceURL + "CreateFanout",
 		serviceURL + "UpdateFanout",
 		serviceURL + "DeleteFanout",
+		serviceURL + "GetMismatches",
+		serviceURL + "SetEndpointSampling",
+		serviceURL + "SetRateLimit",
+		serviceURL + "ListDeadLetters",
+		serviceURL + "GetDeadLetter",
+		serviceURL + "ReplayDeadLetters",
+		serviceURL + "PurgeDeadLetters",
+		serviceURL + "SetSecret",
+		serviceURL + "DeleteSecret",
+		serviceURL + "ListSecrets",
 	}
 
 	return &adminServiceProtobufClient{
@@ -275,75 +320,164 @@ func (c *adminServiceProtobufClient) callDeleteFanout(ctx context.Context, in *D
 	return out, nil
 }
 
-// ========================
-// AdminService JSON Client
-// ========================
-
-type adminServiceJSONClient struct {
-	client      HTTPClient
-	urls        [4]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
+func (c *adminServiceProtobufClient) GetMismatches(ctx context.Context, in *GetMismatchesRequest) (*GetMismatchesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetMismatches")
+	caller := c.callGetMismatches
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetMismatchesRequest) (*GetMismatchesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetMismatchesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetMismatchesRequest) when calling interceptor")
+					}
+					return c.callGetMismatches(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetMismatchesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetMismatchesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewAdminServiceJSONClient creates a JSON client that implements the AdminService interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewAdminServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AdminService {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
+func (c *adminServiceProtobufClient) callGetMismatches(ctx context.Context, in *GetMismatchesRequest) (*GetMismatchesResponse, error) {
+	out := new(GetMismatchesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) SetEndpointSampling(ctx context.Context, in *SetEndpointSamplingRequest) (*SetEndpointSamplingResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetEndpointSampling")
+	caller := c.callSetEndpointSampling
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetEndpointSamplingRequest) (*SetEndpointSamplingResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetEndpointSamplingRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetEndpointSamplingRequest) when calling interceptor")
+					}
+					return c.callSetEndpointSampling(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetEndpointSamplingResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetEndpointSamplingResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (c *adminServiceProtobufClient) callSetEndpointSampling(ctx context.Context, in *SetEndpointSamplingRequest) (*SetEndpointSamplingResponse, error) {
+	out := new(SetEndpointSamplingResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "dfanout", "AdminService")
-	urls := [4]string{
-		serviceURL + "GetFanout",
-		serviceURL + "CreateFanout",
-		serviceURL + "UpdateFanout",
-		serviceURL + "DeleteFanout",
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) SetRateLimit(ctx context.Context, in *SetRateLimitRequest) (*SetRateLimitResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetRateLimit")
+	caller := c.callSetRateLimit
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetRateLimitRequest) (*SetRateLimitResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetRateLimitRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetRateLimitRequest) when calling interceptor")
+					}
+					return c.callSetRateLimit(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetRateLimitResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetRateLimitResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	return &adminServiceJSONClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
+func (c *adminServiceProtobufClient) callSetRateLimit(ctx context.Context, in *SetRateLimitRequest) (*SetRateLimitResponse, error) {
+	out := new(SetRateLimitResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
 }
 
-func (c *adminServiceJSONClient) GetFanout(ctx context.Context, in *GetFanoutRequest) (*GetFanoutResponse, error) {
+func (c *adminServiceProtobufClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
 	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetFanout")
-	caller := c.callGetFanout
+	ctx = ctxsetters.WithMethodName(ctx, "ListDeadLetters")
+	caller := c.callListDeadLetters
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetFanoutRequest) (*GetFanoutResponse, error) {
+		caller = func(ctx context.Context, req *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetFanoutRequest)
+					typedReq, ok := req.(*ListDeadLettersRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetFanoutRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListDeadLettersRequest) when calling interceptor")
 					}
-					return c.callGetFanout(ctx, typedReq)
+					return c.callListDeadLetters(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetFanoutResponse)
+				typedResp, ok := resp.(*ListDeadLettersResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetFanoutResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListDeadLettersResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -353,9 +487,9 @@ func (c *adminServiceJSONClient) GetFanout(ctx context.Context, in *GetFanoutReq
 	return caller(ctx, in)
 }
 
-func (c *adminServiceJSONClient) callGetFanout(ctx context.Context, in *GetFanoutRequest) (*GetFanoutResponse, error) {
-	out := new(GetFanoutResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+func (c *adminServiceProtobufClient) callListDeadLetters(ctx context.Context, in *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+	out := new(ListDeadLettersResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -370,26 +504,26 @@ func (c *adminServiceJSONClient) callGetFanout(ctx context.Context, in *GetFanou
 	return out, nil
 }
 
-func (c *adminServiceJSONClient) CreateFanout(ctx context.Context, in *CreateFanoutRequest) (*CreateFanoutResponse, error) {
+func (c *adminServiceProtobufClient) GetDeadLetter(ctx context.Context, in *GetDeadLetterRequest) (*GetDeadLetterResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
 	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "CreateFanout")
-	caller := c.callCreateFanout
+	ctx = ctxsetters.WithMethodName(ctx, "GetDeadLetter")
+	caller := c.callGetDeadLetter
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *CreateFanoutRequest) (*CreateFanoutResponse, error) {
+		caller = func(ctx context.Context, req *GetDeadLetterRequest) (*GetDeadLetterResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateFanoutRequest)
+					typedReq, ok := req.(*GetDeadLetterRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateFanoutRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetDeadLetterRequest) when calling interceptor")
 					}
-					return c.callCreateFanout(ctx, typedReq)
+					return c.callGetDeadLetter(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateFanoutResponse)
+				typedResp, ok := resp.(*GetDeadLetterResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateFanoutResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetDeadLetterResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -399,9 +533,9 @@ func (c *adminServiceJSONClient) CreateFanout(ctx context.Context, in *CreateFan
 	return caller(ctx, in)
 }
 
-func (c *adminServiceJSONClient) callCreateFanout(ctx context.Context, in *CreateFanoutRequest) (*CreateFanoutResponse, error) {
-	out := new(CreateFanoutResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+func (c *adminServiceProtobufClient) callGetDeadLetter(ctx context.Context, in *GetDeadLetterRequest) (*GetDeadLetterResponse, error) {
+	out := new(GetDeadLetterResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -416,26 +550,26 @@ func (c *adminServiceJSONClient) callCreateFanout(ctx context.Context, in *Creat
 	return out, nil
 }
 
-func (c *adminServiceJSONClient) UpdateFanout(ctx context.Context, in *UpdateFanoutRequest) (*UpdateFanoutRequest, error) {
+func (c *adminServiceProtobufClient) ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
 	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "UpdateFanout")
-	caller := c.callUpdateFanout
+	ctx = ctxsetters.WithMethodName(ctx, "ReplayDeadLetters")
+	caller := c.callReplayDeadLetters
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *UpdateFanoutRequest) (*UpdateFanoutRequest, error) {
+		caller = func(ctx context.Context, req *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpdateFanoutRequest)
+					typedReq, ok := req.(*ReplayDeadLettersRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpdateFanoutRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ReplayDeadLettersRequest) when calling interceptor")
 					}
-					return c.callUpdateFanout(ctx, typedReq)
+					return c.callReplayDeadLetters(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*UpdateFanoutRequest)
+				typedResp, ok := resp.(*ReplayDeadLettersResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*UpdateFanoutRequest) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ReplayDeadLettersResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -445,9 +579,9 @@ func (c *adminServiceJSONClient) UpdateFanout(ctx context.Context, in *UpdateFan
 	return caller(ctx, in)
 }
 
-func (c *adminServiceJSONClient) callUpdateFanout(ctx context.Context, in *UpdateFanoutRequest) (*UpdateFanoutRequest, error) {
-	out := new(UpdateFanoutRequest)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+func (c *adminServiceProtobufClient) callReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error) {
+	out := new(ReplayDeadLettersResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -462,26 +596,26 @@ func (c *adminServiceJSONClient) callUpdateFanout(ctx context.Context, in *Updat
 	return out, nil
 }
 
-func (c *adminServiceJSONClient) DeleteFanout(ctx context.Context, in *DeleteFanoutRequest) (*DeleteFanoutResponse, error) {
+func (c *adminServiceProtobufClient) PurgeDeadLetters(ctx context.Context, in *PurgeDeadLettersRequest) (*PurgeDeadLettersResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
 	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteFanout")
-	caller := c.callDeleteFanout
+	ctx = ctxsetters.WithMethodName(ctx, "PurgeDeadLetters")
+	caller := c.callPurgeDeadLetters
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *DeleteFanoutRequest) (*DeleteFanoutResponse, error) {
+		caller = func(ctx context.Context, req *PurgeDeadLettersRequest) (*PurgeDeadLettersResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteFanoutRequest)
+					typedReq, ok := req.(*PurgeDeadLettersRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteFanoutRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*PurgeDeadLettersRequest) when calling interceptor")
 					}
-					return c.callDeleteFanout(ctx, typedReq)
+					return c.callPurgeDeadLetters(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*DeleteFanoutResponse)
+				typedResp, ok := resp.(*PurgeDeadLettersResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*DeleteFanoutResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*PurgeDeadLettersResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -491,9 +625,9 @@ func (c *adminServiceJSONClient) DeleteFanout(ctx context.Context, in *DeleteFan
 	return caller(ctx, in)
 }
 
-func (c *adminServiceJSONClient) callDeleteFanout(ctx context.Context, in *DeleteFanoutRequest) (*DeleteFanoutResponse, error) {
-	out := new(DeleteFanoutResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+func (c *adminServiceProtobufClient) callPurgeDeadLetters(ctx context.Context, in *PurgeDeadLettersRequest) (*PurgeDeadLettersResponse, error) {
+	out := new(PurgeDeadLettersResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -508,123 +642,2794 @@ func (c *adminServiceJSONClient) callDeleteFanout(ctx context.Context, in *Delet
 	return out, nil
 }
 
-// ===========================
-// AdminService Server Handler
-// ===========================
-
-type adminServiceServer struct {
-	AdminService
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+func (c *adminServiceProtobufClient) SetSecret(ctx context.Context, in *SetSecretRequest) (*SetSecretResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetSecret")
+	caller := c.callSetSecret
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetSecretRequest) (*SetSecretResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetSecretRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetSecretRequest) when calling interceptor")
+					}
+					return c.callSetSecret(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetSecretResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetSecretResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewAdminServiceServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewAdminServiceServer(svc AdminService, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
+func (c *adminServiceProtobufClient) callSetSecret(ctx context.Context, in *SetSecretRequest) (*SetSecretResponse, error) {
+	out := new(SetSecretResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
 
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) DeleteSecret(ctx context.Context, in *DeleteSecretRequest) (*DeleteSecretResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteSecret")
+	caller := c.callDeleteSecret
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteSecretRequest) (*DeleteSecretResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteSecretRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteSecretRequest) when calling interceptor")
+					}
+					return c.callDeleteSecret(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteSecretResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteSecretResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
+}
 
-	return &adminServiceServer{
-		AdminService:     svc,
+func (c *adminServiceProtobufClient) callDeleteSecret(ctx context.Context, in *DeleteSecretRequest) (*DeleteSecretResponse, error) {
+	out := new(DeleteSecretResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceProtobufClient) ListSecrets(ctx context.Context, in *ListSecretsRequest) (*ListSecretsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListSecrets")
+	caller := c.callListSecrets
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListSecretsRequest) (*ListSecretsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSecretsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSecretsRequest) when calling interceptor")
+					}
+					return c.callListSecrets(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSecretsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSecretsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceProtobufClient) callListSecrets(ctx context.Context, in *ListSecretsRequest) (*ListSecretsResponse, error) {
+	out := new(ListSecretsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ========================
+// AdminService JSON Client
+// ========================
+
+type adminServiceJSONClient struct {
+	client      HTTPClient
+	urls        [14]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewAdminServiceJSONClient creates a JSON client that implements the AdminService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewAdminServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AdminService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "dfanout", "AdminService")
+	urls := [14]string{
+		serviceURL + "GetFanout",
+		serviceURL + "CreateFanout",
+		serviceURL + "UpdateFanout",
+		serviceURL + "DeleteFanout",
+		serviceURL + "GetMismatches",
+		serviceURL + "SetEndpointSampling",
+		serviceURL + "SetRateLimit",
+		serviceURL + "ListDeadLetters",
+		serviceURL + "GetDeadLetter",
+		serviceURL + "ReplayDeadLetters",
+		serviceURL + "PurgeDeadLetters",
+		serviceURL + "SetSecret",
+		serviceURL + "DeleteSecret",
+		serviceURL + "ListSecrets",
+	}
+
+	return &adminServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *adminServiceJSONClient) GetFanout(ctx context.Context, in *GetFanoutRequest) (*GetFanoutResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetFanout")
+	caller := c.callGetFanout
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetFanoutRequest) (*GetFanoutResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetFanoutRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetFanoutRequest) when calling interceptor")
+					}
+					return c.callGetFanout(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFanoutResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFanoutResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callGetFanout(ctx context.Context, in *GetFanoutRequest) (*GetFanoutResponse, error) {
+	out := new(GetFanoutResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) CreateFanout(ctx context.Context, in *CreateFanoutRequest) (*CreateFanoutResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateFanout")
+	caller := c.callCreateFanout
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateFanoutRequest) (*CreateFanoutResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateFanoutRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateFanoutRequest) when calling interceptor")
+					}
+					return c.callCreateFanout(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateFanoutResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateFanoutResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callCreateFanout(ctx context.Context, in *CreateFanoutRequest) (*CreateFanoutResponse, error) {
+	out := new(CreateFanoutResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) UpdateFanout(ctx context.Context, in *UpdateFanoutRequest) (*UpdateFanoutRequest, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateFanout")
+	caller := c.callUpdateFanout
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateFanoutRequest) (*UpdateFanoutRequest, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateFanoutRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateFanoutRequest) when calling interceptor")
+					}
+					return c.callUpdateFanout(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateFanoutRequest)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateFanoutRequest) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callUpdateFanout(ctx context.Context, in *UpdateFanoutRequest) (*UpdateFanoutRequest, error) {
+	out := new(UpdateFanoutRequest)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) DeleteFanout(ctx context.Context, in *DeleteFanoutRequest) (*DeleteFanoutResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteFanout")
+	caller := c.callDeleteFanout
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteFanoutRequest) (*DeleteFanoutResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteFanoutRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteFanoutRequest) when calling interceptor")
+					}
+					return c.callDeleteFanout(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteFanoutResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteFanoutResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callDeleteFanout(ctx context.Context, in *DeleteFanoutRequest) (*DeleteFanoutResponse, error) {
+	out := new(DeleteFanoutResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) GetMismatches(ctx context.Context, in *GetMismatchesRequest) (*GetMismatchesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetMismatches")
+	caller := c.callGetMismatches
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetMismatchesRequest) (*GetMismatchesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetMismatchesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetMismatchesRequest) when calling interceptor")
+					}
+					return c.callGetMismatches(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetMismatchesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetMismatchesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callGetMismatches(ctx context.Context, in *GetMismatchesRequest) (*GetMismatchesResponse, error) {
+	out := new(GetMismatchesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) SetEndpointSampling(ctx context.Context, in *SetEndpointSamplingRequest) (*SetEndpointSamplingResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetEndpointSampling")
+	caller := c.callSetEndpointSampling
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetEndpointSamplingRequest) (*SetEndpointSamplingResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetEndpointSamplingRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetEndpointSamplingRequest) when calling interceptor")
+					}
+					return c.callSetEndpointSampling(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetEndpointSamplingResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetEndpointSamplingResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callSetEndpointSampling(ctx context.Context, in *SetEndpointSamplingRequest) (*SetEndpointSamplingResponse, error) {
+	out := new(SetEndpointSamplingResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) SetRateLimit(ctx context.Context, in *SetRateLimitRequest) (*SetRateLimitResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetRateLimit")
+	caller := c.callSetRateLimit
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetRateLimitRequest) (*SetRateLimitResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetRateLimitRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetRateLimitRequest) when calling interceptor")
+					}
+					return c.callSetRateLimit(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetRateLimitResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetRateLimitResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callSetRateLimit(ctx context.Context, in *SetRateLimitRequest) (*SetRateLimitResponse, error) {
+	out := new(SetRateLimitResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListDeadLetters")
+	caller := c.callListDeadLetters
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListDeadLettersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListDeadLettersRequest) when calling interceptor")
+					}
+					return c.callListDeadLetters(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListDeadLettersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListDeadLettersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callListDeadLetters(ctx context.Context, in *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+	out := new(ListDeadLettersResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) GetDeadLetter(ctx context.Context, in *GetDeadLetterRequest) (*GetDeadLetterResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetDeadLetter")
+	caller := c.callGetDeadLetter
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetDeadLetterRequest) (*GetDeadLetterResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetDeadLetterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetDeadLetterRequest) when calling interceptor")
+					}
+					return c.callGetDeadLetter(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetDeadLetterResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetDeadLetterResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callGetDeadLetter(ctx context.Context, in *GetDeadLetterRequest) (*GetDeadLetterResponse, error) {
+	out := new(GetDeadLetterResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ReplayDeadLetters")
+	caller := c.callReplayDeadLetters
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReplayDeadLettersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReplayDeadLettersRequest) when calling interceptor")
+					}
+					return c.callReplayDeadLetters(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReplayDeadLettersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReplayDeadLettersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error) {
+	out := new(ReplayDeadLettersResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) PurgeDeadLetters(ctx context.Context, in *PurgeDeadLettersRequest) (*PurgeDeadLettersResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "PurgeDeadLetters")
+	caller := c.callPurgeDeadLetters
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *PurgeDeadLettersRequest) (*PurgeDeadLettersResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PurgeDeadLettersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PurgeDeadLettersRequest) when calling interceptor")
+					}
+					return c.callPurgeDeadLetters(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PurgeDeadLettersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PurgeDeadLettersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callPurgeDeadLetters(ctx context.Context, in *PurgeDeadLettersRequest) (*PurgeDeadLettersResponse, error) {
+	out := new(PurgeDeadLettersResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) SetSecret(ctx context.Context, in *SetSecretRequest) (*SetSecretResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetSecret")
+	caller := c.callSetSecret
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetSecretRequest) (*SetSecretResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetSecretRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetSecretRequest) when calling interceptor")
+					}
+					return c.callSetSecret(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetSecretResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetSecretResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callSetSecret(ctx context.Context, in *SetSecretRequest) (*SetSecretResponse, error) {
+	out := new(SetSecretResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) DeleteSecret(ctx context.Context, in *DeleteSecretRequest) (*DeleteSecretResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteSecret")
+	caller := c.callDeleteSecret
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteSecretRequest) (*DeleteSecretResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteSecretRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteSecretRequest) when calling interceptor")
+					}
+					return c.callDeleteSecret(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteSecretResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteSecretResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callDeleteSecret(ctx context.Context, in *DeleteSecretRequest) (*DeleteSecretResponse, error) {
+	out := new(DeleteSecretResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminServiceJSONClient) ListSecrets(ctx context.Context, in *ListSecretsRequest) (*ListSecretsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListSecrets")
+	caller := c.callListSecrets
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListSecretsRequest) (*ListSecretsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSecretsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSecretsRequest) when calling interceptor")
+					}
+					return c.callListSecrets(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSecretsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSecretsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminServiceJSONClient) callListSecrets(ctx context.Context, in *ListSecretsRequest) (*ListSecretsResponse, error) {
+	out := new(ListSecretsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===========================
+// AdminService Server Handler
+// ===========================
+
+type adminServiceServer struct {
+	AdminService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewAdminServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewAdminServiceServer(svc AdminService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &adminServiceServer{
+		AdminService:     svc,
 		hooks:            serverOpts.Hooks,
 		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
 		pathPrefix:       pathPrefix,
 		jsonSkipDefaults: jsonSkipDefaults,
 		jsonCamelCase:    jsonCamelCase,
 	}
-}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *adminServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *adminServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// AdminServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const AdminServicePathPrefix = "/twirp/dfanout.AdminService/"
+
+func (s *adminServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "dfanout.AdminService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "GetFanout":
+		s.serveGetFanout(ctx, resp, req)
+		return
+	case "CreateFanout":
+		s.serveCreateFanout(ctx, resp, req)
+		return
+	case "UpdateFanout":
+		s.serveUpdateFanout(ctx, resp, req)
+		return
+	case "DeleteFanout":
+		s.serveDeleteFanout(ctx, resp, req)
+		return
+	case "GetMismatches":
+		s.serveGetMismatches(ctx, resp, req)
+		return
+	case "SetEndpointSampling":
+		s.serveSetEndpointSampling(ctx, resp, req)
+		return
+	case "SetRateLimit":
+		s.serveSetRateLimit(ctx, resp, req)
+		return
+	case "ListDeadLetters":
+		s.serveListDeadLetters(ctx, resp, req)
+		return
+	case "GetDeadLetter":
+		s.serveGetDeadLetter(ctx, resp, req)
+		return
+	case "ReplayDeadLetters":
+		s.serveReplayDeadLetters(ctx, resp, req)
+		return
+	case "PurgeDeadLetters":
+		s.servePurgeDeadLetters(ctx, resp, req)
+		return
+	case "SetSecret":
+		s.serveSetSecret(ctx, resp, req)
+		return
+	case "DeleteSecret":
+		s.serveDeleteSecret(ctx, resp, req)
+		return
+	case "ListSecrets":
+		s.serveListSecrets(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *adminServiceServer) serveGetFanout(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetFanoutJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetFanoutProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveGetFanoutJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetFanout")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetFanoutRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.GetFanout
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetFanoutRequest) (*GetFanoutResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetFanoutRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetFanoutRequest) when calling interceptor")
+					}
+					return s.AdminService.GetFanout(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFanoutResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFanoutResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetFanoutResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetFanoutResponse and nil error while calling GetFanout. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveGetFanoutProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetFanout")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetFanoutRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.GetFanout
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetFanoutRequest) (*GetFanoutResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetFanoutRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetFanoutRequest) when calling interceptor")
+					}
+					return s.AdminService.GetFanout(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFanoutResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFanoutResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetFanoutResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetFanoutResponse and nil error while calling GetFanout. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveCreateFanout(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateFanoutJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateFanoutProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveCreateFanoutJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateFanout")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateFanoutRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.CreateFanout
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateFanoutRequest) (*CreateFanoutResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateFanoutRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateFanoutRequest) when calling interceptor")
+					}
+					return s.AdminService.CreateFanout(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateFanoutResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateFanoutResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateFanoutResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateFanoutResponse and nil error while calling CreateFanout. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveCreateFanoutProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateFanout")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateFanoutRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.CreateFanout
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateFanoutRequest) (*CreateFanoutResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateFanoutRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateFanoutRequest) when calling interceptor")
+					}
+					return s.AdminService.CreateFanout(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateFanoutResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateFanoutResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateFanoutResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateFanoutResponse and nil error while calling CreateFanout. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveUpdateFanout(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateFanoutJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateFanoutProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveUpdateFanoutJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateFanout")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateFanoutRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.UpdateFanout
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateFanoutRequest) (*UpdateFanoutRequest, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateFanoutRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateFanoutRequest) when calling interceptor")
+					}
+					return s.AdminService.UpdateFanout(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateFanoutRequest)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateFanoutRequest) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateFanoutRequest
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateFanoutRequest and nil error while calling UpdateFanout. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveUpdateFanoutProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateFanout")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateFanoutRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.UpdateFanout
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateFanoutRequest) (*UpdateFanoutRequest, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateFanoutRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateFanoutRequest) when calling interceptor")
+					}
+					return s.AdminService.UpdateFanout(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*UpdateFanoutRequest)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*UpdateFanoutRequest) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *UpdateFanoutRequest
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateFanoutRequest and nil error while calling UpdateFanout. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveDeleteFanout(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteFanoutJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteFanoutProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveDeleteFanoutJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteFanout")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteFanoutRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.DeleteFanout
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteFanoutRequest) (*DeleteFanoutResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteFanoutRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteFanoutRequest) when calling interceptor")
+					}
+					return s.AdminService.DeleteFanout(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteFanoutResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteFanoutResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteFanoutResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteFanoutResponse and nil error while calling DeleteFanout. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveDeleteFanoutProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteFanout")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeleteFanoutRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.DeleteFanout
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteFanoutRequest) (*DeleteFanoutResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteFanoutRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteFanoutRequest) when calling interceptor")
+					}
+					return s.AdminService.DeleteFanout(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteFanoutResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteFanoutResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteFanoutResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteFanoutResponse and nil error while calling DeleteFanout. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveGetMismatches(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetMismatchesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetMismatchesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveGetMismatchesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetMismatches")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetMismatchesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.GetMismatches
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetMismatchesRequest) (*GetMismatchesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetMismatchesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetMismatchesRequest) when calling interceptor")
+					}
+					return s.AdminService.GetMismatches(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetMismatchesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetMismatchesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetMismatchesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetMismatchesResponse and nil error while calling GetMismatches. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveGetMismatchesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetMismatches")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetMismatchesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.GetMismatches
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetMismatchesRequest) (*GetMismatchesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetMismatchesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetMismatchesRequest) when calling interceptor")
+					}
+					return s.AdminService.GetMismatches(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetMismatchesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetMismatchesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetMismatchesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetMismatchesResponse and nil error while calling GetMismatches. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveSetEndpointSampling(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetEndpointSamplingJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetEndpointSamplingProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveSetEndpointSamplingJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetEndpointSampling")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SetEndpointSamplingRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.SetEndpointSampling
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetEndpointSamplingRequest) (*SetEndpointSamplingResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetEndpointSamplingRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetEndpointSamplingRequest) when calling interceptor")
+					}
+					return s.AdminService.SetEndpointSampling(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetEndpointSamplingResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetEndpointSamplingResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SetEndpointSamplingResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetEndpointSamplingResponse and nil error while calling SetEndpointSampling. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveSetEndpointSamplingProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetEndpointSampling")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SetEndpointSamplingRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.SetEndpointSampling
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetEndpointSamplingRequest) (*SetEndpointSamplingResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetEndpointSamplingRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetEndpointSamplingRequest) when calling interceptor")
+					}
+					return s.AdminService.SetEndpointSampling(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetEndpointSamplingResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetEndpointSamplingResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SetEndpointSamplingResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetEndpointSamplingResponse and nil error while calling SetEndpointSampling. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveSetRateLimit(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetRateLimitJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetRateLimitProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveSetRateLimitJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetRateLimit")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SetRateLimitRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.SetRateLimit
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetRateLimitRequest) (*SetRateLimitResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetRateLimitRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetRateLimitRequest) when calling interceptor")
+					}
+					return s.AdminService.SetRateLimit(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetRateLimitResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetRateLimitResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SetRateLimitResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetRateLimitResponse and nil error while calling SetRateLimit. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveSetRateLimitProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetRateLimit")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SetRateLimitRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.SetRateLimit
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetRateLimitRequest) (*SetRateLimitResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetRateLimitRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetRateLimitRequest) when calling interceptor")
+					}
+					return s.AdminService.SetRateLimit(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SetRateLimitResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SetRateLimitResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SetRateLimitResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetRateLimitResponse and nil error while calling SetRateLimit. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveListDeadLetters(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListDeadLettersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListDeadLettersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveListDeadLettersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListDeadLetters")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListDeadLettersRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.ListDeadLetters
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListDeadLettersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListDeadLettersRequest) when calling interceptor")
+					}
+					return s.AdminService.ListDeadLetters(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListDeadLettersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListDeadLettersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListDeadLettersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListDeadLettersResponse and nil error while calling ListDeadLetters. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveListDeadLettersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListDeadLetters")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListDeadLettersRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.ListDeadLetters
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListDeadLettersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListDeadLettersRequest) when calling interceptor")
+					}
+					return s.AdminService.ListDeadLetters(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListDeadLettersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListDeadLettersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListDeadLettersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListDeadLettersResponse and nil error while calling ListDeadLetters. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveGetDeadLetter(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetDeadLetterJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetDeadLetterProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveGetDeadLetterJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetDeadLetter")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetDeadLetterRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminService.GetDeadLetter
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetDeadLetterRequest) (*GetDeadLetterResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetDeadLetterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetDeadLetterRequest) when calling interceptor")
+					}
+					return s.AdminService.GetDeadLetter(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetDeadLetterResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetDeadLetterResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetDeadLetterResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetDeadLetterResponse and nil error while calling GetDeadLetter. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveGetDeadLetterProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetDeadLetter")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetDeadLetterRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AdminService.GetDeadLetter
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetDeadLetterRequest) (*GetDeadLetterResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetDeadLetterRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetDeadLetterRequest) when calling interceptor")
+					}
+					return s.AdminService.GetDeadLetter(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetDeadLetterResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetDeadLetterResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetDeadLetterResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetDeadLetterResponse and nil error while calling GetDeadLetter. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServiceServer) serveReplayDeadLetters(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveReplayDeadLettersJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveReplayDeadLettersProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServiceServer) serveReplayDeadLettersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReplayDeadLetters")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ReplayDeadLettersRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *adminServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
-}
+	handler := s.AdminService.ReplayDeadLetters
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReplayDeadLettersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReplayDeadLettersRequest) when calling interceptor")
+					}
+					return s.AdminService.ReplayDeadLetters(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReplayDeadLettersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReplayDeadLettersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *adminServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+	// Call service method
+	var respContent *ReplayDeadLettersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
 	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReplayDeadLettersResponse and nil error while calling ReplayDeadLetters. nil responses are not supported"))
 		return
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
-}
 
-// AdminServicePathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const AdminServicePathPrefix = "/twirp/dfanout.AdminService/"
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-func (s *adminServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "dfanout")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminService")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
 
+func (s *adminServiceServer) serveReplayDeadLettersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
+	ctx = ctxsetters.WithMethodName(ctx, "ReplayDeadLetters")
+	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
 
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "dfanout.AdminService" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	reqContent := new(ReplayDeadLettersRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+
+	handler := s.AdminService.ReplayDeadLetters
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReplayDeadLettersRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReplayDeadLettersRequest) when calling interceptor")
+					}
+					return s.AdminService.ReplayDeadLetters(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReplayDeadLettersResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReplayDeadLettersResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
 
-	switch method {
-	case "GetFanout":
-		s.serveGetFanout(ctx, resp, req)
-		return
-	case "CreateFanout":
-		s.serveCreateFanout(ctx, resp, req)
-		return
-	case "UpdateFanout":
-		s.serveUpdateFanout(ctx, resp, req)
+	// Call service method
+	var respContent *ReplayDeadLettersResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
 		return
-	case "DeleteFanout":
-		s.serveDeleteFanout(ctx, resp, req)
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReplayDeadLettersResponse and nil error while calling ReplayDeadLetters. nil responses are not supported"))
 		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveGetFanout(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) servePurgeDeadLetters(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -632,9 +3437,9 @@ func (s *adminServiceServer) serveGetFanout(ctx context.Context, resp http.Respo
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetFanoutJSON(ctx, resp, req)
+		s.servePurgeDeadLettersJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetFanoutProtobuf(ctx, resp, req)
+		s.servePurgeDeadLettersProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -642,9 +3447,9 @@ func (s *adminServiceServer) serveGetFanout(ctx context.Context, resp http.Respo
 	}
 }
 
-func (s *adminServiceServer) serveGetFanoutJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) servePurgeDeadLettersJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetFanout")
+	ctx = ctxsetters.WithMethodName(ctx, "PurgeDeadLetters")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -657,29 +3462,29 @@ func (s *adminServiceServer) serveGetFanoutJSON(ctx context.Context, resp http.R
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(GetFanoutRequest)
+	reqContent := new(PurgeDeadLettersRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.AdminService.GetFanout
+	handler := s.AdminService.PurgeDeadLetters
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetFanoutRequest) (*GetFanoutResponse, error) {
+		handler = func(ctx context.Context, req *PurgeDeadLettersRequest) (*PurgeDeadLettersResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetFanoutRequest)
+					typedReq, ok := req.(*PurgeDeadLettersRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetFanoutRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*PurgeDeadLettersRequest) when calling interceptor")
 					}
-					return s.AdminService.GetFanout(ctx, typedReq)
+					return s.AdminService.PurgeDeadLetters(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetFanoutResponse)
+				typedResp, ok := resp.(*PurgeDeadLettersResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetFanoutResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*PurgeDeadLettersResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -688,7 +3493,7 @@ func (s *adminServiceServer) serveGetFanoutJSON(ctx context.Context, resp http.R
 	}
 
 	// Call service method
-	var respContent *GetFanoutResponse
+	var respContent *PurgeDeadLettersResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -699,7 +3504,7 @@ func (s *adminServiceServer) serveGetFanoutJSON(ctx context.Context, resp http.R
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetFanoutResponse and nil error while calling GetFanout. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PurgeDeadLettersResponse and nil error while calling PurgeDeadLetters. nil responses are not supported"))
 		return
 	}
 
@@ -725,42 +3530,42 @@ func (s *adminServiceServer) serveGetFanoutJSON(ctx context.Context, resp http.R
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveGetFanoutProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) servePurgeDeadLettersProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetFanout")
+	ctx = ctxsetters.WithMethodName(ctx, "PurgeDeadLetters")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
 
-	buf, err := ioutil.ReadAll(req.Body)
+	buf, err := io.ReadAll(req.Body)
 	if err != nil {
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(GetFanoutRequest)
+	reqContent := new(PurgeDeadLettersRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.AdminService.GetFanout
+	handler := s.AdminService.PurgeDeadLetters
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetFanoutRequest) (*GetFanoutResponse, error) {
+		handler = func(ctx context.Context, req *PurgeDeadLettersRequest) (*PurgeDeadLettersResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetFanoutRequest)
+					typedReq, ok := req.(*PurgeDeadLettersRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetFanoutRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*PurgeDeadLettersRequest) when calling interceptor")
 					}
-					return s.AdminService.GetFanout(ctx, typedReq)
+					return s.AdminService.PurgeDeadLetters(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetFanoutResponse)
+				typedResp, ok := resp.(*PurgeDeadLettersResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetFanoutResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*PurgeDeadLettersResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -769,7 +3574,7 @@ func (s *adminServiceServer) serveGetFanoutProtobuf(ctx context.Context, resp ht
 	}
 
 	// Call service method
-	var respContent *GetFanoutResponse
+	var respContent *PurgeDeadLettersResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -780,7 +3585,7 @@ func (s *adminServiceServer) serveGetFanoutProtobuf(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetFanoutResponse and nil error while calling GetFanout. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PurgeDeadLettersResponse and nil error while calling PurgeDeadLetters. nil responses are not supported"))
 		return
 	}
 
@@ -804,7 +3609,7 @@ func (s *adminServiceServer) serveGetFanoutProtobuf(ctx context.Context, resp ht
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveCreateFanout(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveSetSecret(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -812,9 +3617,9 @@ func (s *adminServiceServer) serveCreateFanout(ctx context.Context, resp http.Re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveCreateFanoutJSON(ctx, resp, req)
+		s.serveSetSecretJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveCreateFanoutProtobuf(ctx, resp, req)
+		s.serveSetSecretProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -822,9 +3627,9 @@ func (s *adminServiceServer) serveCreateFanout(ctx context.Context, resp http.Re
 	}
 }
 
-func (s *adminServiceServer) serveCreateFanoutJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveSetSecretJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateFanout")
+	ctx = ctxsetters.WithMethodName(ctx, "SetSecret")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -837,29 +3642,29 @@ func (s *adminServiceServer) serveCreateFanoutJSON(ctx context.Context, resp htt
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(CreateFanoutRequest)
+	reqContent := new(SetSecretRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.AdminService.CreateFanout
+	handler := s.AdminService.SetSecret
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateFanoutRequest) (*CreateFanoutResponse, error) {
+		handler = func(ctx context.Context, req *SetSecretRequest) (*SetSecretResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateFanoutRequest)
+					typedReq, ok := req.(*SetSecretRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateFanoutRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SetSecretRequest) when calling interceptor")
 					}
-					return s.AdminService.CreateFanout(ctx, typedReq)
+					return s.AdminService.SetSecret(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateFanoutResponse)
+				typedResp, ok := resp.(*SetSecretResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateFanoutResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SetSecretResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -868,7 +3673,7 @@ func (s *adminServiceServer) serveCreateFanoutJSON(ctx context.Context, resp htt
 	}
 
 	// Call service method
-	var respContent *CreateFanoutResponse
+	var respContent *SetSecretResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -879,7 +3684,7 @@ func (s *adminServiceServer) serveCreateFanoutJSON(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateFanoutResponse and nil error while calling CreateFanout. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetSecretResponse and nil error while calling SetSecret. nil responses are not supported"))
 		return
 	}
 
@@ -905,42 +3710,42 @@ func (s *adminServiceServer) serveCreateFanoutJSON(ctx context.Context, resp htt
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveCreateFanoutProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveSetSecretProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateFanout")
+	ctx = ctxsetters.WithMethodName(ctx, "SetSecret")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
 
-	buf, err := ioutil.ReadAll(req.Body)
+	buf, err := io.ReadAll(req.Body)
 	if err != nil {
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(CreateFanoutRequest)
+	reqContent := new(SetSecretRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.AdminService.CreateFanout
+	handler := s.AdminService.SetSecret
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateFanoutRequest) (*CreateFanoutResponse, error) {
+		handler = func(ctx context.Context, req *SetSecretRequest) (*SetSecretResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateFanoutRequest)
+					typedReq, ok := req.(*SetSecretRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateFanoutRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SetSecretRequest) when calling interceptor")
 					}
-					return s.AdminService.CreateFanout(ctx, typedReq)
+					return s.AdminService.SetSecret(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateFanoutResponse)
+				typedResp, ok := resp.(*SetSecretResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateFanoutResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SetSecretResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -949,7 +3754,7 @@ func (s *adminServiceServer) serveCreateFanoutProtobuf(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *CreateFanoutResponse
+	var respContent *SetSecretResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -960,7 +3765,7 @@ func (s *adminServiceServer) serveCreateFanoutProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateFanoutResponse and nil error while calling CreateFanout. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SetSecretResponse and nil error while calling SetSecret. nil responses are not supported"))
 		return
 	}
 
@@ -984,7 +3789,7 @@ func (s *adminServiceServer) serveCreateFanoutProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveUpdateFanout(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveDeleteSecret(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -992,9 +3797,9 @@ func (s *adminServiceServer) serveUpdateFanout(ctx context.Context, resp http.Re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveUpdateFanoutJSON(ctx, resp, req)
+		s.serveDeleteSecretJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveUpdateFanoutProtobuf(ctx, resp, req)
+		s.serveDeleteSecretProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1002,9 +3807,9 @@ func (s *adminServiceServer) serveUpdateFanout(ctx context.Context, resp http.Re
 	}
 }
 
-func (s *adminServiceServer) serveUpdateFanoutJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveDeleteSecretJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "UpdateFanout")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteSecret")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1017,29 +3822,29 @@ func (s *adminServiceServer) serveUpdateFanoutJSON(ctx context.Context, resp htt
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(UpdateFanoutRequest)
+	reqContent := new(DeleteSecretRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.AdminService.UpdateFanout
+	handler := s.AdminService.DeleteSecret
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *UpdateFanoutRequest) (*UpdateFanoutRequest, error) {
+		handler = func(ctx context.Context, req *DeleteSecretRequest) (*DeleteSecretResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpdateFanoutRequest)
+					typedReq, ok := req.(*DeleteSecretRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpdateFanoutRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteSecretRequest) when calling interceptor")
 					}
-					return s.AdminService.UpdateFanout(ctx, typedReq)
+					return s.AdminService.DeleteSecret(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*UpdateFanoutRequest)
+				typedResp, ok := resp.(*DeleteSecretResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*UpdateFanoutRequest) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteSecretResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1048,7 +3853,7 @@ func (s *adminServiceServer) serveUpdateFanoutJSON(ctx context.Context, resp htt
 	}
 
 	// Call service method
-	var respContent *UpdateFanoutRequest
+	var respContent *DeleteSecretResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1059,7 +3864,7 @@ func (s *adminServiceServer) serveUpdateFanoutJSON(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateFanoutRequest and nil error while calling UpdateFanout. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteSecretResponse and nil error while calling DeleteSecret. nil responses are not supported"))
 		return
 	}
 
@@ -1085,42 +3890,42 @@ func (s *adminServiceServer) serveUpdateFanoutJSON(ctx context.Context, resp htt
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveUpdateFanoutProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveDeleteSecretProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "UpdateFanout")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteSecret")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
 
-	buf, err := ioutil.ReadAll(req.Body)
+	buf, err := io.ReadAll(req.Body)
 	if err != nil {
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(UpdateFanoutRequest)
+	reqContent := new(DeleteSecretRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.AdminService.UpdateFanout
+	handler := s.AdminService.DeleteSecret
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *UpdateFanoutRequest) (*UpdateFanoutRequest, error) {
+		handler = func(ctx context.Context, req *DeleteSecretRequest) (*DeleteSecretResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpdateFanoutRequest)
+					typedReq, ok := req.(*DeleteSecretRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpdateFanoutRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteSecretRequest) when calling interceptor")
 					}
-					return s.AdminService.UpdateFanout(ctx, typedReq)
+					return s.AdminService.DeleteSecret(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*UpdateFanoutRequest)
+				typedResp, ok := resp.(*DeleteSecretResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*UpdateFanoutRequest) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteSecretResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1129,7 +3934,7 @@ func (s *adminServiceServer) serveUpdateFanoutProtobuf(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *UpdateFanoutRequest
+	var respContent *DeleteSecretResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1140,7 +3945,7 @@ func (s *adminServiceServer) serveUpdateFanoutProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *UpdateFanoutRequest and nil error while calling UpdateFanout. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteSecretResponse and nil error while calling DeleteSecret. nil responses are not supported"))
 		return
 	}
 
@@ -1164,7 +3969,7 @@ func (s *adminServiceServer) serveUpdateFanoutProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveDeleteFanout(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveListSecrets(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1172,9 +3977,9 @@ func (s *adminServiceServer) serveDeleteFanout(ctx context.Context, resp http.Re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveDeleteFanoutJSON(ctx, resp, req)
+		s.serveListSecretsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveDeleteFanoutProtobuf(ctx, resp, req)
+		s.serveListSecretsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1182,9 +3987,9 @@ func (s *adminServiceServer) serveDeleteFanout(ctx context.Context, resp http.Re
 	}
 }
 
-func (s *adminServiceServer) serveDeleteFanoutJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveListSecretsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteFanout")
+	ctx = ctxsetters.WithMethodName(ctx, "ListSecrets")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1197,29 +4002,29 @@ func (s *adminServiceServer) serveDeleteFanoutJSON(ctx context.Context, resp htt
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(DeleteFanoutRequest)
+	reqContent := new(ListSecretsRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.AdminService.DeleteFanout
+	handler := s.AdminService.ListSecrets
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *DeleteFanoutRequest) (*DeleteFanoutResponse, error) {
+		handler = func(ctx context.Context, req *ListSecretsRequest) (*ListSecretsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteFanoutRequest)
+					typedReq, ok := req.(*ListSecretsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteFanoutRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListSecretsRequest) when calling interceptor")
 					}
-					return s.AdminService.DeleteFanout(ctx, typedReq)
+					return s.AdminService.ListSecrets(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*DeleteFanoutResponse)
+				typedResp, ok := resp.(*ListSecretsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*DeleteFanoutResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSecretsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1228,7 +4033,7 @@ func (s *adminServiceServer) serveDeleteFanoutJSON(ctx context.Context, resp htt
 	}
 
 	// Call service method
-	var respContent *DeleteFanoutResponse
+	var respContent *ListSecretsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1239,7 +4044,7 @@ func (s *adminServiceServer) serveDeleteFanoutJSON(ctx context.Context, resp htt
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteFanoutResponse and nil error while calling DeleteFanout. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListSecretsResponse and nil error while calling ListSecrets. nil responses are not supported"))
 		return
 	}
 
@@ -1265,42 +4070,42 @@ func (s *adminServiceServer) serveDeleteFanoutJSON(ctx context.Context, resp htt
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminServiceServer) serveDeleteFanoutProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminServiceServer) serveListSecretsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "DeleteFanout")
+	ctx = ctxsetters.WithMethodName(ctx, "ListSecrets")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
 
-	buf, err := ioutil.ReadAll(req.Body)
+	buf, err := io.ReadAll(req.Body)
 	if err != nil {
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(DeleteFanoutRequest)
+	reqContent := new(ListSecretsRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.AdminService.DeleteFanout
+	handler := s.AdminService.ListSecrets
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *DeleteFanoutRequest) (*DeleteFanoutResponse, error) {
+		handler = func(ctx context.Context, req *ListSecretsRequest) (*ListSecretsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DeleteFanoutRequest)
+					typedReq, ok := req.(*ListSecretsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DeleteFanoutRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListSecretsRequest) when calling interceptor")
 					}
-					return s.AdminService.DeleteFanout(ctx, typedReq)
+					return s.AdminService.ListSecrets(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*DeleteFanoutResponse)
+				typedResp, ok := resp.(*ListSecretsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*DeleteFanoutResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSecretsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1309,7 +4114,7 @@ func (s *adminServiceServer) serveDeleteFanoutProtobuf(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *DeleteFanoutResponse
+	var respContent *ListSecretsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1320,7 +4125,7 @@ func (s *adminServiceServer) serveDeleteFanoutProtobuf(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteFanoutResponse and nil error while calling DeleteFanout. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListSecretsResponse and nil error while calling ListSecrets. nil responses are not supported"))
 		return
 	}
 
@@ -1349,7 +4154,7 @@ func (s *adminServiceServer) ServiceDescriptor() ([]byte, int) {
 }
 
 func (s *adminServiceServer) ProtocGenTwirpVersion() string {
-	return "v8.1.2"
+	return "v8.1.3"
 }
 
 // PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
@@ -1468,7 +4273,7 @@ func writeError(ctx context.Context, resp http.ResponseWriter, err error, hooks
 }
 
 // sanitizeBaseURL parses the the baseURL, and adds the "http" scheme if needed.
-// If the URL is unparsable, the baseURL is returned unchaged.
+// If the URL is unparsable, the baseURL is returned unchanged.
 func sanitizeBaseURL(baseURL string) string {
 	u, err := url.Parse(baseURL)
 	if err != nil {
@@ -1482,9 +4287,12 @@ func sanitizeBaseURL(baseURL string) string {
 
 // baseServicePath composes the path prefix for the service (without <Method>).
 // e.g.: baseServicePath("/twirp", "my.pkg", "MyService")
-//       returns => "/twirp/my.pkg.MyService/"
+//
+//	returns => "/twirp/my.pkg.MyService/"
+//
 // e.g.: baseServicePath("", "", "MyService")
-//       returns => "/MyService/"
+//
+//	returns => "/MyService/"
 func baseServicePath(prefix, pkg, service string) string {
 	fullServiceName := service
 	if pkg != "" {
@@ -1539,7 +4347,7 @@ func newRequest(ctx context.Context, url string, reqBody io.Reader, contentType
 	}
 	req.Header.Set("Accept", contentType)
 	req.Header.Set("Content-Type", contentType)
-	req.Header.Set("Twirp-Version", "v8.1.2")
+	req.Header.Set("Twirp-Version", "v8.1.3")
 	return req, nil
 }
 
@@ -1590,7 +4398,7 @@ func errorFromResponse(resp *http.Response) twirp.Error {
 		return twirpErrorFromIntermediary(statusCode, msg, location)
 	}
 
-	respBodyBytes, err := ioutil.ReadAll(resp.Body)
+	respBodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return wrapInternal(err, "failed to read server error response body")
 	}
@@ -1790,7 +4598,7 @@ func doProtobufRequest(ctx context.Context, client HTTPClient, hooks *twirp.Clie
 		return ctx, errorFromResponse(resp)
 	}
 
-	respBodyBytes, err := ioutil.ReadAll(resp.Body)
+	respBodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return ctx, wrapInternal(err, "failed to read response body")
 	}
@@ -1922,47 +4730,251 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0xc5, 0x49, 0x9b, 0xc4, 0x37, 0xa9, 0x68, 0x27, 0x6d, 0x71, 0x23, 0xaa, 0x46, 0xde, 0x10,
-	0x21, 0x91, 0x42, 0x90, 0xd8, 0xc0, 0xa6, 0x0f, 0xa0, 0x3c, 0x55, 0xb9, 0x81, 0x05, 0x1b, 0xcb,
-	0xb5, 0x6f, 0x9a, 0x51, 0xe2, 0x07, 0x33, 0xe3, 0x4a, 0xf9, 0x00, 0x3e, 0x86, 0x35, 0x1b, 0x3e,
-	0x8a, 0x8f, 0x40, 0x33, 0x1e, 0xbb, 0x76, 0x49, 0x51, 0xbb, 0x8a, 0xef, 0x9c, 0x73, 0xcf, 0x3d,
-	0xf7, 0xa1, 0x40, 0x37, 0x61, 0xb1, 0x88, 0xf7, 0x39, 0xb2, 0x4b, 0xea, 0xe3, 0x50, 0x45, 0xa4,
-	0x19, 0x4c, 0xbc, 0x28, 0x4e, 0x85, 0xfd, 0xc3, 0x80, 0xd6, 0xeb, 0x28, 0x48, 0x62, 0x1a, 0x09,
-	0x42, 0x60, 0x25, 0xf2, 0x42, 0xb4, 0x8c, 0xbe, 0x31, 0x30, 0x1d, 0xf5, 0x4d, 0x2c, 0x68, 0x26,
-	0x8c, 0x86, 0x1e, 0x5b, 0x58, 0xb5, 0xbe, 0x31, 0x68, 0x39, 0x79, 0x48, 0x5e, 0xc1, 0xda, 0x54,
-	0x88, 0xc4, 0x45, 0x9d, 0x6e, 0xd5, 0xfb, 0xc6, 0xa0, 0x3d, 0xda, 0x1a, 0x6a, 0xed, 0xe1, 0xc9,
-	0x78, 0x7c, 0x9a, 0x6b, 0x9f, 0xdc, 0x73, 0x3a, 0x92, 0x9d, 0xc7, 0x87, 0x6b, 0xd0, 0x0e, 0x90,
-	0x0b, 0x1a, 0x79, 0x82, 0xc6, 0x91, 0x3d, 0x82, 0xc6, 0x09, 0x7a, 0x01, 0x32, 0xb2, 0x0e, 0xf5,
-	0x19, 0x2e, 0xb4, 0x07, 0xf9, 0x49, 0xb6, 0xa1, 0x71, 0xe9, 0xcd, 0x53, 0xe4, 0x56, 0xad, 0x5f,
-	0x1f, 0x98, 0x8e, 0x8e, 0xec, 0x5f, 0x06, 0x74, 0xca, 0x35, 0x64, 0x6a, 0xca, 0xe6, 0x79, 0x6a,
-	0xca, 0xe6, 0x32, 0x35, 0x44, 0x31, 0x8d, 0x03, 0x65, 0xde, 0x74, 0x74, 0x44, 0x76, 0x01, 0x04,
-	0x0d, 0x31, 0x4e, 0x85, 0x1b, 0x72, 0x65, 0xbc, 0xee, 0x98, 0xfa, 0xe5, 0x13, 0x27, 0x8f, 0xa0,
-	0x31, 0x55, 0x6e, 0xac, 0x95, 0x7e, 0x7d, 0xd0, 0x1e, 0xdd, 0xbf, 0xea, 0x49, 0x3d, 0x3b, 0x1a,
-	0x26, 0xcf, 0x00, 0xc4, 0x9c, 0xbb, 0x7e, 0x1c, 0x4d, 0xe8, 0x85, 0xb5, 0xaa, 0x06, 0x40, 0x0a,
-	0xf2, 0xf8, 0xe3, 0xd9, 0x91, 0x42, 0x1c, 0x53, 0xcc, 0x79, 0xf6, 0x69, 0xff, 0x34, 0xc0, 0x2c,
-	0x00, 0xf2, 0x14, 0x36, 0x69, 0xc4, 0xd1, 0x4f, 0x19, 0xba, 0x7c, 0x46, 0x13, 0xf7, 0x12, 0x19,
-	0x9d, 0x64, 0xed, 0xb7, 0x1c, 0x92, 0x63, 0x67, 0x33, 0x9a, 0x7c, 0x55, 0x08, 0xd9, 0x83, 0xb6,
-	0xdc, 0x25, 0x32, 0x57, 0xed, 0x2a, 0xeb, 0x0b, 0xb2, 0xa7, 0xcf, 0x72, 0x63, 0x5b, 0xd0, 0xf0,
-	0x3d, 0x37, 0xc1, 0x50, 0xf5, 0xd5, 0x71, 0x56, 0x7d, 0xef, 0x14, 0x43, 0xb2, 0x03, 0x2d, 0x1f,
-	0x99, 0x50, 0xc0, 0x8a, 0x02, 0x9a, 0x32, 0x96, 0xd0, 0x03, 0x68, 0xce, 0x70, 0xa1, 0x90, 0x55,
-	0x85, 0x34, 0x66, 0xb8, 0x38, 0xc5, 0xd0, 0x7e, 0x02, 0xeb, 0x6f, 0x51, 0xbc, 0x51, 0xdd, 0x38,
-	0xf8, 0x3d, 0x45, 0x2e, 0xa4, 0xce, 0xc4, 0x8b, 0xdc, 0xd2, 0xa1, 0x34, 0x27, 0x5e, 0x24, 0x2b,
-	0xdb, 0xc7, 0xb0, 0x51, 0xa2, 0xf3, 0x24, 0x8e, 0x38, 0x92, 0x7d, 0x30, 0xf3, 0x0b, 0xe1, 0x96,
-	0xa1, 0xc6, 0xb9, 0x51, 0x4c, 0x28, 0x5f, 0x9d, 0x73, 0xc5, 0xb1, 0x2f, 0xa0, 0x7b, 0xc4, 0xd0,
-	0x13, 0x58, 0xad, 0xbb, 0x07, 0xed, 0x2c, 0xa9, 0x5c, 0x1a, 0xb2, 0x27, 0xd5, 0x77, 0xa5, 0x50,
-	0xed, 0x16, 0x85, 0x46, 0xb0, 0x59, 0x2d, 0xa4, 0x1d, 0xf7, 0xa0, 0x55, 0xdc, 0x74, 0x56, 0xa6,
-	0x88, 0xed, 0x3f, 0x06, 0x74, 0xbf, 0x24, 0xc1, 0xdd, 0xdd, 0x1d, 0x40, 0xb7, 0xa8, 0xec, 0x8a,
-	0xd8, 0x95, 0x9b, 0x65, 0xe2, 0x66, 0x9f, 0x1b, 0x05, 0x7b, 0x1c, 0xbf, 0x53, 0xdc, 0x7f, 0x24,
-	0x52, 0xe5, 0xc3, 0xaa, 0xdf, 0x46, 0x22, 0xf3, 0x4c, 0x86, 0xd7, 0x24, 0x02, 0x9c, 0xa3, 0x40,
-	0x75, 0xe5, 0x66, 0x85, 0x7f, 0xac, 0x00, 0x7b, 0x1b, 0x36, 0xab, 0xdd, 0x66, 0x23, 0xb2, 0x5f,
-	0x40, 0x37, 0x63, 0xdc, 0x6d, 0x0a, 0x52, 0xaf, 0x9a, 0x97, 0xe9, 0x8d, 0x7e, 0xd7, 0xa0, 0x73,
-	0x10, 0x84, 0x34, 0x3a, 0xcb, 0xfe, 0xa6, 0xc8, 0x21, 0x98, 0xc5, 0x29, 0x91, 0x9d, 0xa2, 0xb7,
-	0xeb, 0xd7, 0xd8, 0xeb, 0x2d, 0x83, 0xf4, 0x1e, 0x3f, 0x40, 0xa7, 0xbc, 0x5f, 0xf2, 0xb0, 0xe0,
-	0x2e, 0xb9, 0xaf, 0xde, 0xee, 0x0d, 0xa8, 0x16, 0x7b, 0x0f, 0x9d, 0xf2, 0x24, 0x4a, 0x62, 0x4b,
-	0xce, 0xa1, 0xf7, 0x5f, 0x54, 0x1a, 0x2b, 0x4f, 0xa1, 0xa4, 0xb5, 0x64, 0xa8, 0x25, 0x63, 0xcb,
-	0x46, 0x77, 0xf8, 0xf8, 0xdb, 0xe0, 0x82, 0x8a, 0x69, 0x7a, 0x3e, 0xf4, 0xe3, 0x70, 0x5f, 0x53,
-	0x8b, 0x5f, 0xf5, 0x77, 0xff, 0x52, 0x47, 0xe7, 0x0d, 0x15, 0x3e, 0xff, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x5b, 0xab, 0x63, 0x2e, 0x14, 0x06, 0x00, 0x00,
+	// 3932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4b, 0x73, 0x1b, 0x49,
+	0x72, 0x56, 0x03, 0x24, 0x1e, 0x09, 0x90, 0x04, 0x0b, 0x14, 0x05, 0x41, 0xa2, 0x44, 0xf5, 0xec,
+	0x7a, 0x24, 0x6a, 0xa5, 0x99, 0xd1, 0xcc, 0x68, 0x62, 0x63, 0x26, 0xc2, 0x0b, 0x92, 0x20, 0x09,
+	0x0d, 0x41, 0x40, 0x05, 0x70, 0x65, 0x4d, 0x38, 0xa2, 0xdd, 0x44, 0x17, 0xc8, 0x16, 0x1b, 0xdd,
+	0x50, 0x75, 0x81, 0x24, 0x7c, 0xdd, 0xab, 0x23, 0x1c, 0xbe, 0xf8, 0xb0, 0xf6, 0xc1, 0xf6, 0xd9,
+	0x8e, 0xbd, 0xf9, 0x66, 0xdf, 0x7d, 0xf1, 0xd1, 0x3e, 0x39, 0xc2, 0x7f, 0xc0, 0xff, 0xc1, 0x51,
+	0x8f, 0x7e, 0x02, 0xd4, 0x50, 0xeb, 0xb1, 0xc3, 0x27, 0xa2, 0xf2, 0xcb, 0xcc, 0xca, 0xca, 0x7a,
+	0x7d, 0x59, 0x4d, 0xa8, 0x8e, 0xa9, 0xc7, 0xbc, 0xcf, 0x7c, 0x42, 0x2f, 0xec, 0x01, 0x79, 0x2e,
+	0x5a, 0x28, 0x6f, 0x0d, 0x4d, 0xd7, 0x9b, 0x30, 0xfd, 0xaf, 0xf3, 0x50, 0x68, 0xba, 0xd6, 0xd8,
+	0xb3, 0x5d, 0x86, 0x10, 0x2c, 0xb8, 0xe6, 0x88, 0xd4, 0xb4, 0x4d, 0xed, 0x71, 0x11, 0x8b, 0xdf,
+	0xa8, 0x06, 0xf9, 0x31, 0xb5, 0x47, 0x26, 0x9d, 0xd6, 0x32, 0x9b, 0xda, 0xe3, 0x02, 0x0e, 0x9a,
+	0xe8, 0x1b, 0x28, 0x0f, 0x4d, 0xdb, 0x99, 0x50, 0x62, 0x8c, 0x3c, 0x8b, 0xd4, 0x0a, 0x9b, 0xda,
+	0xe3, 0xe5, 0x17, 0x6b, 0xcf, 0x95, 0xeb, 0xe7, 0x7b, 0x12, 0x6c, 0x7b, 0x16, 0xc1, 0xa5, 0x61,
+	0xd4, 0xe0, 0x86, 0x94, 0x30, 0x3a, 0x35, 0xc6, 0x9e, 0x63, 0x0f, 0xa6, 0xb5, 0xfc, 0xa6, 0xf6,
+	0xb8, 0x14, 0x33, 0xc4, 0x1c, 0xec, 0x0a, 0x0c, 0x97, 0x68, 0xd4, 0x40, 0xcf, 0xa0, 0xe0, 0x9b,
+	0xa3, 0xb1, 0x63, 0xbb, 0xa7, 0xb5, 0xa2, 0x30, 0x5a, 0x0d, 0x8d, 0x7a, 0x0a, 0xc0, 0xa1, 0x0a,
+	0xfa, 0x12, 0x4a, 0x23, 0x93, 0x0d, 0xce, 0x0c, 0x3a, 0x71, 0x88, 0x5f, 0x83, 0xcd, 0xec, 0xe3,
+	0xd2, 0x0b, 0x14, 0x5a, 0xb4, 0x39, 0x86, 0x27, 0x0e, 0xc1, 0x30, 0x0a, 0x7e, 0xfa, 0xe8, 0x73,
+	0x28, 0x32, 0x6a, 0xba, 0xfe, 0xd0, 0xa3, 0xa3, 0x5a, 0x69, 0x53, 0x4b, 0x98, 0xf4, 0x03, 0x04,
+	0x47, 0x4a, 0x68, 0x07, 0x2a, 0xd6, 0x84, 0x9a, 0x27, 0x0e, 0x31, 0x2c, 0xe2, 0xd8, 0x17, 0x84,
+	0x4e, 0x6b, 0x65, 0x61, 0x58, 0x0b, 0x0d, 0x77, 0xa5, 0xc2, 0xae, 0xc2, 0xf1, 0x8a, 0x95, 0x14,
+	0xa0, 0x5f, 0xc1, 0xca, 0xc0, 0xa6, 0x83, 0x89, 0xcd, 0x8c, 0x13, 0x4a, 0xcc, 0x73, 0x42, 0x6b,
+	0x4b, 0xc2, 0xc7, 0x9d, 0xd0, 0xc7, 0x8e, 0xc4, 0xb7, 0x25, 0x8c, 0x97, 0x07, 0x89, 0x36, 0xda,
+	0x83, 0xd5, 0x81, 0xe7, 0x0e, 0x26, 0x94, 0x12, 0x77, 0x30, 0x35, 0x1c, 0x7b, 0x64, 0xb3, 0xda,
+	0xb2, 0xf0, 0x71, 0x37, 0xf2, 0x11, 0x69, 0x1c, 0x72, 0x05, 0x5c, 0x19, 0xa4, 0x24, 0xe8, 0x15,
+	0x54, 0xbd, 0x09, 0x3b, 0xf1, 0x26, 0xae, 0x65, 0x50, 0x93, 0x11, 0xe5, 0x69, 0x45, 0x78, 0xaa,
+	0x87, 0x9e, 0x3a, 0x4a, 0x07, 0x9b, 0x8c, 0x48, 0x57, 0xab, 0x5e, 0x5a, 0x84, 0x3e, 0x83, 0xea,
+	0xd0, 0xa3, 0x97, 0x26, 0xb5, 0x8c, 0x01, 0x25, 0x16, 0x71, 0x99, 0x6d, 0x3a, 0x7e, 0xad, 0x22,
+	0x16, 0x12, 0x52, 0xd0, 0x4e, 0x84, 0xa0, 0xef, 0x60, 0xe9, 0x8c, 0xb1, 0xb1, 0x41, 0xd4, 0x92,
+	0xac, 0x65, 0x45, 0xb7, 0xb7, 0xc3, 0x6e, 0x0f, 0xfa, 0xfd, 0x6e, 0xb0, 0x5e, 0x0f, 0x6e, 0xe1,
+	0x32, 0xd7, 0x0e, 0xda, 0xdc, 0xfa, 0x94, 0x8e, 0x07, 0x91, 0xf5, 0x42, 0xca, 0x7a, 0x1f, 0x77,
+	0x77, 0xe2, 0xd6, 0x5c, 0x3b, 0xb4, 0xfe, 0x43, 0x58, 0x66, 0x97, 0x36, 0x8d, 0x75, 0xbe, 0x28,
+	0xcc, 0xd7, 0xa3, 0xe9, 0xe7, 0x70, 0xcc, 0x7e, 0x89, 0xc5, 0x05, 0x68, 0x1b, 0x56, 0xa4, 0x62,
+	0xe4, 0x21, 0x97, 0x9a, 0xc3, 0x3d, 0xf1, 0x27, 0xe6, 0x62, 0x79, 0x98, 0x90, 0x6c, 0x2f, 0x41,
+	0xc9, 0x22, 0x3e, 0xb3, 0x5d, 0x93, 0xd9, 0x9e, 0xab, 0x77, 0x20, 0x77, 0x40, 0x4c, 0x8b, 0x50,
+	0x54, 0x81, 0xec, 0x39, 0x99, 0xaa, 0xad, 0xc9, 0x7f, 0xa2, 0x75, 0xc8, 0x5d, 0x98, 0xce, 0x84,
+	0xf8, 0xb5, 0xcc, 0x66, 0xf6, 0x71, 0x11, 0xab, 0x16, 0xba, 0x07, 0x45, 0xf1, 0xcb, 0xa0, 0x64,
+	0x28, 0xf2, 0x57, 0xc4, 0x05, 0x21, 0xc0, 0x64, 0xa8, 0xff, 0x9b, 0x06, 0xe5, 0x78, 0x0e, 0xb9,
+	0xdf, 0x09, 0x75, 0x02, 0xbf, 0x13, 0xea, 0x70, 0xbf, 0x23, 0xc2, 0xce, 0x3c, 0x4b, 0x6c, 0xf8,
+	0x22, 0x56, 0x2d, 0xb4, 0x01, 0xc0, 0xec, 0x11, 0xe1, 0xe3, 0x1b, 0xf9, 0xc2, 0x71, 0x16, 0x17,
+	0x95, 0xa4, 0xed, 0xa3, 0x4f, 0x21, 0x77, 0x26, 0x42, 0xad, 0x2d, 0x88, 0x8d, 0xb6, 0x12, 0xcd,
+	0x99, 0x10, 0x63, 0x05, 0xa3, 0x2f, 0x00, 0x98, 0xe3, 0x1b, 0x03, 0xcf, 0x1d, 0xda, 0xa7, 0xb5,
+	0xc5, 0xf4, 0x16, 0x3b, 0xec, 0xed, 0x08, 0x04, 0x17, 0x99, 0xe3, 0xcb, 0x9f, 0xe8, 0x11, 0x94,
+	0x83, 0x75, 0x34, 0x36, 0xd9, 0x99, 0x48, 0x6b, 0x01, 0x97, 0x94, 0xac, 0x6b, 0xb2, 0x33, 0xfd,
+	0x9f, 0x34, 0x28, 0xc7, 0xa7, 0x97, 0x0f, 0x83, 0x99, 0xf4, 0x94, 0x30, 0x35, 0x36, 0xd5, 0xfa,
+	0x7d, 0x87, 0xf7, 0x14, 0x0a, 0x23, 0xc2, 0x4c, 0xcb, 0x64, 0xe6, 0x75, 0x03, 0x0c, 0x15, 0x7e,
+	0x8f, 0x21, 0xea, 0xff, 0x98, 0x81, 0xa5, 0xc4, 0xfa, 0x42, 0x77, 0xa1, 0x70, 0x62, 0xfa, 0xc4,
+	0x88, 0xa6, 0x27, 0xcf, 0xdb, 0xc7, 0xd4, 0x41, 0x0f, 0xa1, 0xc4, 0xf3, 0x60, 0x8c, 0x29, 0x19,
+	0xda, 0x57, 0x6a, 0x20, 0xc0, 0x45, 0x5d, 0x21, 0xe1, 0xa7, 0xb6, 0x3a, 0xf0, 0xd5, 0x0a, 0x08,
+	0x9a, 0xb1, 0xe1, 0x2f, 0x24, 0x86, 0xff, 0x1d, 0x94, 0x07, 0x9e, 0xcb, 0x88, 0xcb, 0x0c, 0x36,
+	0x1d, 0x13, 0x11, 0xf4, 0x72, 0xec, 0xe4, 0x10, 0xb1, 0xed, 0x48, 0x8d, 0xfe, 0x74, 0x4c, 0x70,
+	0x69, 0x10, 0x35, 0x52, 0xc9, 0xcb, 0x5d, 0xbf, 0x36, 0xf2, 0x1f, 0xb3, 0x36, 0x0a, 0x37, 0x49,
+	0xdc, 0x63, 0x58, 0x4e, 0xee, 0x2a, 0x3e, 0x44, 0x69, 0x10, 0xcc, 0xbc, 0x6c, 0xe9, 0xbf, 0xd5,
+	0xa0, 0x18, 0x9e, 0xe0, 0xe8, 0x05, 0xe4, 0x65, 0xa7, 0x7e, 0x4d, 0xdb, 0xcc, 0x26, 0x4e, 0x6b,
+	0x19, 0x54, 0x67, 0x4c, 0xa8, 0xd8, 0x85, 0x38, 0x50, 0x44, 0xcf, 0x60, 0xf1, 0xfd, 0x84, 0x88,
+	0xab, 0x30, 0x9b, 0xd8, 0xd7, 0xaf, 0xb9, 0x34, 0x32, 0x90, 0x5a, 0x68, 0x0b, 0x16, 0x4e, 0x3c,
+	0x6b, 0x5a, 0xcb, 0x6e, 0x66, 0x13, 0xe7, 0xc8, 0xb6, 0x67, 0xc5, 0x94, 0x85, 0x8e, 0xfe, 0x0f,
+	0x1a, 0xac, 0xa4, 0xfa, 0x45, 0x9f, 0x40, 0xd6, 0x57, 0xeb, 0x77, 0x36, 0x67, 0x07, 0xb7, 0x30,
+	0x47, 0xb9, 0x92, 0x69, 0xc9, 0xc5, 0x3c, 0x5f, 0xc9, 0xb4, 0x2c, 0x54, 0x83, 0x1c, 0x25, 0x23,
+	0xef, 0x42, 0x2d, 0x87, 0x83, 0x5b, 0x58, 0xb5, 0xd1, 0x13, 0x8e, 0x88, 0x5b, 0x7f, 0x21, 0xe5,
+	0x01, 0x0b, 0xb1, 0x54, 0xe5, 0xbf, 0xb6, 0x4b, 0x50, 0xf4, 0x82, 0xd8, 0xf4, 0xbf, 0xd7, 0x60,
+	0x39, 0x39, 0xea, 0xff, 0xd7, 0xe1, 0xfe, 0x4e, 0x83, 0xa5, 0x44, 0xda, 0xd1, 0xcf, 0xe3, 0xd1,
+	0xc6, 0x78, 0x04, 0x61, 0x7b, 0x36, 0x71, 0xac, 0x20, 0xde, 0x1a, 0xe4, 0x2c, 0xe2, 0x10, 0x46,
+	0xe4, 0x2e, 0xe3, 0xfe, 0x65, 0x1b, 0xfd, 0x1c, 0x16, 0xc2, 0x10, 0xe7, 0x06, 0xb2, 0xf0, 0x3f,
+	0x8a, 0xf8, 0x2b, 0x28, 0x04, 0xb1, 0x70, 0x62, 0x26, 0xce, 0x3d, 0x45, 0xcc, 0xf8, 0x6f, 0xb4,
+	0x06, 0x8b, 0xe2, 0x54, 0x57, 0xbb, 0x5f, 0x36, 0xf4, 0x5f, 0x40, 0x4e, 0xba, 0xe5, 0x36, 0x43,
+	0xea, 0x8d, 0x02, 0x1b, 0xfe, 0x1b, 0x2d, 0x43, 0x86, 0x79, 0xca, 0x20, 0xc3, 0x3c, 0xfd, 0x9f,
+	0x35, 0x28, 0x86, 0x34, 0x88, 0x1f, 0x1a, 0xf2, 0x30, 0x90, 0x3b, 0xa2, 0x88, 0x83, 0x26, 0x7a,
+	0x1e, 0xed, 0x15, 0xb9, 0xf2, 0xd7, 0x52, 0x13, 0x27, 0x9d, 0x84, 0xfb, 0xe4, 0x5b, 0x28, 0x8b,
+	0x1d, 0x60, 0x8c, 0x4d, 0x6a, 0x8a, 0xd3, 0x34, 0xb9, 0xc1, 0xc4, 0xc2, 0xe9, 0x72, 0x4c, 0x1a,
+	0x96, 0xde, 0x87, 0x02, 0x1f, 0x6d, 0xc1, 0xaa, 0x38, 0xdc, 0xfc, 0xc9, 0x70, 0x68, 0x5f, 0x19,
+	0x94, 0x9c, 0x92, 0x2b, 0x75, 0x58, 0xad, 0x70, 0xa0, 0x27, 0xe4, 0x98, 0x8b, 0xf5, 0x6f, 0xa0,
+	0x14, 0x0b, 0x60, 0x2e, 0x81, 0x9d, 0x9f, 0xa7, 0x6f, 0x61, 0x25, 0x15, 0xc4, 0x47, 0x18, 0xff,
+	0xb9, 0x06, 0x85, 0x80, 0x6f, 0xa2, 0x07, 0x00, 0x63, 0x42, 0x07, 0xc4, 0x65, 0xe6, 0xa9, 0x34,
+	0xd6, 0x70, 0x4c, 0xc2, 0x17, 0x90, 0x3a, 0xfb, 0xc2, 0x05, 0x24, 0xdb, 0xe8, 0x11, 0x94, 0x62,
+	0x59, 0x0a, 0x97, 0x3a, 0x44, 0xc9, 0x40, 0x0f, 0x01, 0xde, 0xf9, 0x9e, 0x6b, 0x0c, 0xf9, 0x32,
+	0x90, 0x49, 0x38, 0xb8, 0x85, 0x8b, 0x5c, 0x26, 0x56, 0xc6, 0xf6, 0xa2, 0xa0, 0x05, 0xfa, 0xdf,
+	0x69, 0xb0, 0x92, 0xe2, 0x98, 0x7c, 0x3a, 0x89, 0xcb, 0x25, 0x96, 0x88, 0xaa, 0x80, 0x83, 0x26,
+	0xbf, 0x4e, 0x47, 0xe6, 0x95, 0x61, 0x32, 0x46, 0x46, 0x63, 0xe6, 0x8b, 0xc0, 0x16, 0x71, 0x69,
+	0x64, 0x5e, 0x35, 0x94, 0x08, 0xfd, 0x02, 0x90, 0xed, 0xda, 0x9c, 0x94, 0x19, 0x27, 0xe6, 0xe0,
+	0xdc, 0x1b, 0x0e, 0xa3, 0x5b, 0xb1, 0xa2, 0x90, 0x6d, 0x09, 0xb4, 0x7d, 0xf4, 0x33, 0x58, 0xe6,
+	0x0e, 0x63, 0x9a, 0x0b, 0x42, 0x93, 0x77, 0x13, 0x6a, 0xe9, 0xbf, 0xc9, 0xc0, 0x72, 0x92, 0xc4,
+	0x7e, 0x20, 0xc6, 0x2f, 0x60, 0x6d, 0xe0, 0xb9, 0x3e, 0x19, 0x4c, 0x98, 0x7d, 0x41, 0x0c, 0x55,
+	0x3f, 0x04, 0xb1, 0x56, 0x63, 0x98, 0xaa, 0x33, 0x7c, 0x7e, 0x09, 0x11, 0x4a, 0x3d, 0x2a, 0x68,
+	0xab, 0x88, 0x55, 0xc3, 0x45, 0x21, 0xc1, 0x26, 0x23, 0x62, 0xd4, 0xb6, 0x6b, 0x50, 0xf2, 0x7e,
+	0x42, 0x7c, 0x26, 0x43, 0xe4, 0xa3, 0xb6, 0x5d, 0xac, 0x44, 0x9c, 0x3a, 0x5d, 0xda, 0xae, 0xe5,
+	0x5d, 0xf2, 0x21, 0x2c, 0x8a, 0x21, 0x14, 0xa4, 0xa0, 0xed, 0xa3, 0x3b, 0x90, 0xf7, 0xc6, 0xc4,
+	0x8d, 0x2e, 0xb8, 0x1c, 0x6f, 0xb6, 0x45, 0xae, 0xce, 0x4c, 0x67, 0x68, 0x08, 0x34, 0x74, 0x9f,
+	0x17, 0xee, 0x2b, 0x1c, 0xe9, 0x8c, 0x49, 0xd8, 0x87, 0xfe, 0xdb, 0x2c, 0x94, 0x62, 0x15, 0xce,
+	0xcc, 0x64, 0x68, 0x37, 0x9d, 0x8c, 0xcc, 0x8d, 0x27, 0x23, 0x3b, 0x3b, 0x19, 0xe8, 0x19, 0xa0,
+	0x50, 0x63, 0xe2, 0x30, 0x7b, 0xec, 0xd8, 0x82, 0xba, 0xf1, 0xa4, 0xad, 0x2a, 0xa4, 0x1d, 0x02,
+	0xfc, 0x4e, 0x7d, 0x67, 0x33, 0x46, 0xa8, 0x48, 0x8b, 0x86, 0x55, 0x0b, 0x7d, 0x05, 0xeb, 0xa2,
+	0x42, 0x13, 0xe5, 0x8f, 0xcf, 0x4c, 0x36, 0xe1, 0xb7, 0xb7, 0x45, 0x78, 0x8e, 0xb2, 0x8f, 0x17,
+	0xf1, 0x5a, 0x88, 0xf6, 0x04, 0xb8, 0xc3, 0x31, 0xf4, 0x39, 0x48, 0xb9, 0xe1, 0x12, 0x76, 0xe9,
+	0xd1, 0x73, 0x43, 0xcc, 0x92, 0xcc, 0x59, 0x01, 0x23, 0x81, 0x1d, 0x49, 0xa8, 0x29, 0x10, 0xf4,
+	0x25, 0xac, 0x8f, 0x09, 0x0d, 0xb2, 0x64, 0xc4, 0xc8, 0x46, 0x41, 0x0c, 0xae, 0x3a, 0x26, 0x54,
+	0xe5, 0xab, 0x1f, 0xd2, 0x8e, 0xa8, 0x1b, 0xcf, 0x35, 0x6c, 0x8b, 0x8c, 0xc6, 0x1e, 0x23, 0x2e,
+	0xab, 0x15, 0xe3, 0xdd, 0x78, 0x6e, 0x2b, 0x44, 0xf4, 0x7f, 0xe1, 0x14, 0x21, 0x60, 0x19, 0xdc,
+	0xde, 0x16, 0xcb, 0x8c, 0x12, 0xc3, 0x3f, 0xb7, 0xc7, 0xc6, 0x05, 0xa1, 0xf6, 0x70, 0xaa, 0x96,
+	0x2a, 0x0a, 0xb0, 0xde, 0xb9, 0x3d, 0xfe, 0xb5, 0x40, 0x38, 0x31, 0xe3, 0x44, 0x8b, 0x50, 0x43,
+	0x1c, 0x25, 0x8a, 0x98, 0x49, 0xd1, 0x11, 0x3f, 0x50, 0x6e, 0x43, 0x6e, 0x60, 0x1a, 0x63, 0x22,
+	0xb7, 0x7b, 0x19, 0x2f, 0x0e, 0xcc, 0x2e, 0x19, 0x71, 0xae, 0x37, 0x20, 0x94, 0x09, 0x60, 0x41,
+	0x00, 0x79, 0xde, 0xe6, 0xd0, 0x1d, 0xc8, 0x9f, 0x93, 0xa9, 0x40, 0x16, 0x05, 0x92, 0x3b, 0x27,
+	0x53, 0x0e, 0x3c, 0x80, 0x92, 0x02, 0x04, 0xd3, 0xcf, 0x89, 0xbe, 0x8a, 0x12, 0xe4, 0x54, 0xff,
+	0xcf, 0x16, 0xa0, 0x2c, 0x99, 0x91, 0x1a, 0xce, 0xa7, 0x8a, 0x8e, 0xc8, 0x2b, 0xaf, 0x9a, 0xa0,
+	0x23, 0x52, 0x45, 0x72, 0x91, 0x14, 0x9b, 0xcb, 0xa4, 0xd9, 0xdc, 0x3a, 0xe4, 0xde, 0x4f, 0x3c,
+	0x3a, 0x91, 0x63, 0x58, 0xc4, 0xaa, 0x85, 0x7e, 0x09, 0x30, 0xf0, 0x46, 0x63, 0x93, 0xda, 0xbe,
+	0xe7, 0xaa, 0xdb, 0x2e, 0x5e, 0x7a, 0x06, 0x90, 0xea, 0x2b, 0xa6, 0xcc, 0x43, 0x13, 0x6f, 0x08,
+	0x92, 0x75, 0x56, 0x53, 0xf5, 0x92, 0x78, 0x42, 0x10, 0x0a, 0xe8, 0x25, 0x14, 0xcd, 0xd3, 0x53,
+	0x4a, 0x4e, 0xf9, 0x16, 0xcf, 0xa5, 0xaa, 0xec, 0x46, 0x80, 0x04, 0x2c, 0x31, 0x54, 0x45, 0x5b,
+	0xb0, 0x78, 0x46, 0xac, 0x53, 0x32, 0xf3, 0xd8, 0x70, 0xc0, 0xa5, 0x4a, 0x5f, 0xaa, 0xa0, 0x4d,
+	0x28, 0x5f, 0x9a, 0x36, 0x33, 0x86, 0x1e, 0x35, 0x4c, 0xc7, 0x11, 0x2b, 0xac, 0x80, 0x81, 0xcb,
+	0xf6, 0x3c, 0xda, 0x70, 0x9c, 0xf9, 0xb5, 0x76, 0xf1, 0xe3, 0x6b, 0xed, 0x2f, 0x00, 0x62, 0x25,
+	0x36, 0xa4, 0xe8, 0x6e, 0x54, 0x5a, 0x17, 0x69, 0xf0, 0x93, 0x3f, 0x9e, 0xd8, 0xae, 0xac, 0xce,
+	0xcd, 0x09, 0x3b, 0xab, 0x95, 0x52, 0xe3, 0x69, 0x49, 0xb0, 0x31, 0x61, 0x67, 0xb8, 0x64, 0x47,
+	0x0d, 0xfd, 0x5f, 0x33, 0x50, 0x8a, 0x81, 0x68, 0x0b, 0x0a, 0xe6, 0xd8, 0x36, 0xce, 0xc9, 0x34,
+	0x20, 0xc0, 0x11, 0x33, 0x69, 0x74, 0x5b, 0xdf, 0x93, 0x29, 0xce, 0x9b, 0x63, 0xfb, 0x7b, 0x32,
+	0x15, 0x47, 0x8a, 0xd2, 0x35, 0xe2, 0x77, 0x19, 0x2e, 0x4b, 0x05, 0x55, 0xa2, 0x3e, 0x83, 0xe2,
+	0xd9, 0xc8, 0x1c, 0x48, 0x97, 0xf2, 0xca, 0xaf, 0x44, 0x79, 0x6e, 0x37, 0x76, 0xb8, 0xcf, 0x02,
+	0x57, 0x11, 0x4e, 0x3f, 0x85, 0x8a, 0x50, 0xe7, 0x87, 0x95, 0x7f, 0x4e, 0x2e, 0xa3, 0x6b, 0x63,
+	0x89, 0xcb, 0xdb, 0xe6, 0x55, 0xef, 0x9c, 0xf0, 0x83, 0x57, 0x87, 0xec, 0xbb, 0xcb, 0xa0, 0x1a,
+	0x8f, 0x3c, 0xbe, 0x7a, 0xd3, 0x17, 0xa3, 0xe4, 0xa0, 0x20, 0x63, 0xcc, 0xf1, 0x6b, 0xb9, 0x14,
+	0x9d, 0x6b, 0xf7, 0x0f, 0x7b, 0x42, 0x4b, 0xc0, 0xe8, 0xe7, 0xb0, 0xec, 0x4f, 0x4e, 0xde, 0x91,
+	0x01, 0x33, 0xc2, 0x82, 0x84, 0x0f, 0x64, 0x49, 0x49, 0xd5, 0x48, 0x3e, 0x81, 0x25, 0x49, 0x7d,
+	0x02, 0xad, 0x82, 0x1c, 0xae, 0x14, 0x4a, 0x25, 0xfd, 0x2b, 0xc8, 0xc9, 0x3c, 0xcd, 0x65, 0x0e,
+	0xeb, 0x90, 0xf3, 0xcf, 0xcc, 0x17, 0x5f, 0xbf, 0x0c, 0xca, 0x4c, 0xd9, 0xd2, 0xbb, 0x90, 0x57,
+	0xa9, 0xe0, 0x6c, 0xcc, 0xb6, 0x94, 0x51, 0xc6, 0xb6, 0x84, 0x09, 0x19, 0x50, 0xc2, 0x42, 0x13,
+	0xd1, 0xe2, 0xdb, 0x51, 0xfe, 0x8a, 0x55, 0xf4, 0x45, 0x29, 0xe1, 0xfb, 0xfc, 0x35, 0xe4, 0x55,
+	0x2a, 0x78, 0x20, 0xef, 0x2e, 0xcf, 0xfd, 0x20, 0x10, 0xfe, 0x9b, 0x7b, 0xb5, 0x7d, 0x7f, 0x12,
+	0xce, 0x99, 0x6a, 0xa1, 0x3a, 0x14, 0xcc, 0x89, 0x65, 0x13, 0x37, 0xac, 0x11, 0xc3, 0xb6, 0xfe,
+	0x35, 0x14, 0x82, 0xc4, 0xa1, 0x27, 0x50, 0x31, 0x1d, 0xc7, 0xbb, 0x24, 0x96, 0xa1, 0x92, 0x14,
+	0xd0, 0xc3, 0x15, 0x25, 0xef, 0x29, 0xb1, 0xfe, 0x17, 0x1a, 0x14, 0xa3, 0xc7, 0x9f, 0xe7, 0x50,
+	0x0d, 0x2e, 0x43, 0x83, 0x9f, 0xdd, 0x3e, 0x19, 0x78, 0xae, 0xa5, 0x18, 0xd2, 0x6a, 0x00, 0x75,
+	0x09, 0xed, 0x09, 0x80, 0x73, 0xad, 0x93, 0x09, 0xf5, 0x99, 0xba, 0xe2, 0x65, 0x23, 0x46, 0x9f,
+	0xb2, 0x29, 0xfa, 0xb4, 0x01, 0xc5, 0x81, 0x63, 0xf3, 0x82, 0xd5, 0x1e, 0x8b, 0x85, 0x53, 0x38,
+	0xb8, 0x85, 0x0b, 0x52, 0xd4, 0x1a, 0x07, 0xcc, 0xe8, 0x77, 0x1a, 0xac, 0xce, 0xbc, 0x55, 0xfd,
+	0x44, 0xb1, 0xe9, 0xb0, 0xc4, 0x17, 0xaf, 0xed, 0x1a, 0x43, 0xc7, 0x3e, 0x3d, 0x63, 0xb5, 0x6c,
+	0x78, 0x77, 0xb7, 0xdc, 0x3d, 0x21, 0x42, 0x9f, 0x43, 0xce, 0x1c, 0x70, 0x42, 0x2f, 0x42, 0x5c,
+	0x8e, 0x9d, 0x56, 0x9d, 0x0b, 0x42, 0x45, 0x34, 0x0d, 0x81, 0x63, 0xa5, 0xa7, 0xff, 0x46, 0x83,
+	0x4a, 0xfa, 0xec, 0x08, 0x58, 0x42, 0xc8, 0x2e, 0x22, 0x96, 0x10, 0x92, 0x97, 0x2d, 0x58, 0x7d,
+	0x3f, 0x21, 0x13, 0x62, 0x9c, 0x10, 0x9f, 0x19, 0x64, 0x38, 0xf4, 0x28, 0x53, 0x6f, 0xb6, 0x2b,
+	0x02, 0xd8, 0x26, 0x3e, 0x6b, 0x0a, 0x31, 0xda, 0x94, 0xee, 0xa4, 0x7e, 0xc8, 0x10, 0x60, 0x64,
+	0x5e, 0xbd, 0xe6, 0xa2, 0xb6, 0xaf, 0x5f, 0x40, 0x29, 0x76, 0x34, 0xf2, 0x0b, 0xca, 0x22, 0x8e,
+	0x39, 0x35, 0x46, 0xb2, 0xef, 0x2c, 0xce, 0x8b, 0xb6, 0x64, 0x12, 0x8e, 0xc9, 0xc4, 0x41, 0xa8,
+	0x68, 0xaf, 0xed, 0xc8, 0xab, 0x4f, 0xc3, 0xab, 0x0a, 0xe9, 0x86, 0x00, 0x5f, 0xcd, 0xbc, 0x6b,
+	0x71, 0xd4, 0xfa, 0x2a, 0x63, 0xc5, 0x91, 0x79, 0x25, 0x7a, 0xf3, 0xf5, 0x7f, 0xd7, 0x60, 0x25,
+	0x75, 0x8e, 0xa3, 0x97, 0x50, 0xf0, 0x19, 0x3f, 0x02, 0x4f, 0xe5, 0xe5, 0xb5, 0x1c, 0x7b, 0x87,
+	0x0c, 0x75, 0x7b, 0x4a, 0x03, 0x87, 0xba, 0xe2, 0x51, 0xd5, 0x73, 0x87, 0x8e, 0x3d, 0x60, 0xc1,
+	0x5b, 0x73, 0x46, 0x98, 0xdf, 0x89, 0x1f, 0xd2, 0x02, 0x57, 0xcf, 0xcd, 0xcb, 0x83, 0x44, 0x1b,
+	0xdd, 0x87, 0x62, 0xf0, 0x96, 0x27, 0x8f, 0xb4, 0x22, 0x8e, 0x04, 0x9c, 0x97, 0xc9, 0x49, 0xf1,
+	0xc7, 0x9c, 0x8e, 0x1a, 0x27, 0x53, 0x46, 0x82, 0x33, 0xac, 0x22, 0xa6, 0x46, 0x02, 0xdb, 0x5c,
+	0xae, 0xff, 0x87, 0x98, 0xd7, 0xe4, 0x25, 0xf8, 0x61, 0x92, 0x6e, 0x9f, 0xba, 0x1e, 0x25, 0xe2,
+	0xc9, 0x2b, 0x78, 0xe4, 0x2b, 0x49, 0x19, 0x7f, 0xf2, 0xf2, 0xb9, 0x71, 0x50, 0x96, 0xc9, 0xd8,
+	0x82, 0x26, 0x7a, 0x0a, 0xab, 0xee, 0x64, 0x44, 0xa8, 0x3d, 0x30, 0x98, 0xe7, 0x10, 0x6a, 0xf2,
+	0x5d, 0x2e, 0xc9, 0x5d, 0x45, 0x01, 0xfd, 0x40, 0x1e, 0x12, 0x46, 0xcf, 0x9a, 0xaa, 0x21, 0x2c,
+	0x46, 0x84, 0xd1, 0xb3, 0xa6, 0x22, 0x7c, 0x41, 0x6d, 0x78, 0xcd, 0x43, 0x24, 0xbd, 0xce, 0x09,
+	0x67, 0x20, 0x45, 0x7c, 0x63, 0xe9, 0x7f, 0xa3, 0x01, 0x44, 0x54, 0x42, 0x24, 0x87, 0x8c, 0x3c,
+	0xaa, 0xae, 0x47, 0xe5, 0x59, 0x53, 0xc9, 0x11, 0x88, 0x58, 0xda, 0xd2, 0xfb, 0x3d, 0x28, 0x8a,
+	0x18, 0x84, 0x92, 0x64, 0x1c, 0x05, 0xde, 0xbd, 0x00, 0xf7, 0xa1, 0xe2, 0x5d, 0x10, 0xea, 0xdb,
+	0x7f, 0x4a, 0xac, 0x60, 0x22, 0xb3, 0x62, 0x22, 0xef, 0x27, 0x76, 0x93, 0x50, 0xe0, 0x21, 0xa8,
+	0xd9, 0x5c, 0x09, 0xad, 0xa4, 0x40, 0x7f, 0x06, 0x95, 0x7d, 0xc2, 0x24, 0xa9, 0x50, 0xfb, 0x86,
+	0xaf, 0xec, 0xa1, 0xe9, 0x1a, 0xb1, 0x03, 0x3c, 0x3f, 0x34, 0x5d, 0x4e, 0xd6, 0x74, 0x1f, 0x56,
+	0x63, 0xea, 0x72, 0x2e, 0xd1, 0x67, 0xf1, 0x25, 0x21, 0x2f, 0xce, 0xe8, 0xba, 0x09, 0xde, 0xa0,
+	0xe2, 0xab, 0xe4, 0x19, 0xe4, 0xd4, 0x7b, 0x56, 0x26, 0xf5, 0x1c, 0x1d, 0x67, 0x67, 0x58, 0x29,
+	0xe9, 0x7f, 0xa9, 0x41, 0x75, 0x87, 0x12, 0x93, 0x91, 0x64, 0x9c, 0x0f, 0xa1, 0x24, 0xcd, 0xe2,
+	0xa1, 0x82, 0x14, 0x09, 0x6a, 0x99, 0x08, 0x2c, 0xf3, 0x51, 0x81, 0x65, 0x6f, 0x12, 0xd8, 0x0b,
+	0x58, 0x4b, 0xc6, 0xa5, 0x12, 0x52, 0x87, 0x42, 0xe0, 0x53, 0x45, 0x15, 0xb6, 0xf5, 0xbf, 0xca,
+	0x40, 0xf5, 0x78, 0x6c, 0x7d, 0xfc, 0x60, 0x1a, 0x50, 0x0d, 0x03, 0x35, 0x98, 0x67, 0x70, 0xae,
+	0x4d, 0xd9, 0xf5, 0xc3, 0x5a, 0x0d, 0xb5, 0xfb, 0x5e, 0x4b, 0xe8, 0xce, 0xb8, 0x98, 0x88, 0x38,
+	0x6a, 0xd9, 0x9b, 0xb8, 0x90, 0x31, 0xf3, 0x6b, 0x22, 0xe1, 0x42, 0xbd, 0x04, 0x2d, 0x88, 0xcd,
+	0x16, 0xd7, 0xdf, 0x15, 0x40, 0x2c, 0xa3, 0x8b, 0x37, 0xc9, 0xe8, 0x3a, 0xac, 0x25, 0x93, 0x23,
+	0x33, 0xaa, 0xbf, 0x84, 0xaa, 0x74, 0xf8, 0x71, 0x49, 0xe3, 0xfe, 0x92, 0x76, 0xca, 0xdf, 0x1f,
+	0xc3, 0xda, 0x3e, 0x61, 0x6d, 0xdb, 0x17, 0xdf, 0xb9, 0x88, 0x7f, 0xe3, 0x59, 0xf8, 0x04, 0x96,
+	0x82, 0x41, 0xc6, 0x0b, 0x9a, 0x72, 0x20, 0x14, 0xbd, 0x62, 0xb8, 0x9d, 0xf2, 0xae, 0x16, 0xc6,
+	0x2f, 0x67, 0x77, 0xca, 0xbd, 0x99, 0xb4, 0xc7, 0xec, 0x22, 0x6d, 0xfe, 0x9e, 0x81, 0x66, 0x35,
+	0x66, 0xe3, 0xd1, 0x66, 0xe3, 0xe1, 0xeb, 0x51, 0x56, 0x16, 0xc4, 0x0a, 0x4e, 0x92, 0xa0, 0xcd,
+	0x1f, 0x6b, 0x46, 0x81, 0x3b, 0x2b, 0xbc, 0xf5, 0x42, 0x09, 0x7a, 0x0a, 0x79, 0x79, 0xa2, 0xf9,
+	0xb5, 0x85, 0xd4, 0x3a, 0x09, 0xc2, 0xc0, 0x81, 0x86, 0x3e, 0x80, 0x42, 0x20, 0xe4, 0x17, 0x2a,
+	0x2f, 0x90, 0x8c, 0x89, 0x6b, 0x5f, 0x45, 0x77, 0xa4, 0x28, 0xa3, 0x8e, 0x5d, 0xfb, 0xaa, 0xed,
+	0xa3, 0xaf, 0xa1, 0x64, 0xd9, 0xc3, 0x21, 0xe1, 0x97, 0x3a, 0x09, 0x36, 0x68, 0x54, 0xe9, 0xec,
+	0x86, 0x18, 0x8e, 0xeb, 0xe9, 0x7f, 0x04, 0x10, 0x41, 0x73, 0x1f, 0x02, 0x53, 0x5f, 0x68, 0x8b,
+	0xd1, 0x17, 0xda, 0xfb, 0x50, 0x94, 0xc4, 0x86, 0x63, 0x11, 0x6f, 0x94, 0x02, 0xce, 0xd6, 0xea,
+	0x3d, 0x12, 0x3e, 0x9b, 0x87, 0x1f, 0x50, 0x7f, 0xca, 0xc5, 0x91, 0xf8, 0x64, 0x9b, 0xfd, 0xd1,
+	0x4f, 0xb6, 0xfa, 0x06, 0xdc, 0x9b, 0x1b, 0x92, 0x5a, 0xc8, 0x36, 0x54, 0x7b, 0x84, 0x45, 0x75,
+	0xd1, 0x4d, 0x43, 0x4d, 0xd6, 0x59, 0x99, 0x1b, 0xd4, 0x59, 0x7c, 0x2f, 0x25, 0xbb, 0x52, 0x21,
+	0xfc, 0x67, 0x06, 0x60, 0x97, 0x98, 0xd6, 0x21, 0x11, 0xef, 0x1f, 0x11, 0x87, 0xcf, 0x0a, 0x0e,
+	0x9f, 0x0a, 0x25, 0xf3, 0xe3, 0x59, 0xcb, 0xce, 0xc9, 0xda, 0x75, 0x1f, 0x69, 0xd4, 0xc7, 0xba,
+	0xc5, 0xe8, 0x63, 0xdd, 0x93, 0x88, 0x02, 0xe4, 0xe6, 0x7f, 0x5a, 0x09, 0x70, 0xbe, 0x76, 0x44,
+	0xf9, 0x9f, 0x17, 0xaf, 0x08, 0xe2, 0xb7, 0x28, 0x02, 0x82, 0x87, 0xa7, 0x82, 0xa0, 0x62, 0x61,
+	0x9b, 0x13, 0x35, 0xc7, 0xe4, 0x4c, 0x92, 0xbf, 0xc0, 0x88, 0xea, 0xb6, 0x88, 0x8b, 0x5c, 0x22,
+	0x9e, 0x64, 0xd0, 0x53, 0x40, 0x03, 0x71, 0x1d, 0x58, 0x86, 0xc9, 0xc2, 0x75, 0x0f, 0x22, 0x13,
+	0x2b, 0x0a, 0x69, 0x30, 0xb5, 0xf8, 0x9f, 0x02, 0x92, 0xc7, 0x6f, 0x42, 0xb9, 0x24, 0x95, 0x15,
+	0x12, 0x28, 0xf3, 0xe7, 0xd5, 0xf5, 0x43, 0xdb, 0x67, 0x51, 0x9a, 0x7f, 0xda, 0x13, 0x8b, 0x5f,
+	0xf9, 0xe6, 0x90, 0x11, 0x6a, 0xd8, 0xc1, 0x19, 0x90, 0x17, 0xed, 0x96, 0x20, 0xfa, 0x72, 0x91,
+	0xc8, 0xd7, 0x41, 0xd9, 0xd0, 0x5f, 0xc3, 0x9d, 0x99, 0x80, 0xd4, 0x21, 0xf7, 0x12, 0xca, 0x16,
+	0x31, 0x2d, 0xc3, 0x91, 0xf2, 0x9a, 0x96, 0xde, 0xd7, 0xa1, 0x0d, 0x2e, 0x59, 0x91, 0xbd, 0xfe,
+	0x07, 0xe2, 0x4c, 0x8e, 0xa1, 0x6a, 0x84, 0xa9, 0x05, 0xa5, 0xb7, 0xe1, 0x76, 0x4a, 0x4f, 0x75,
+	0xfc, 0x15, 0x94, 0x62, 0x1d, 0xcf, 0x3c, 0xea, 0xc4, 0x2c, 0x20, 0xea, 0x57, 0xa7, 0x50, 0xc3,
+	0x64, 0xec, 0x98, 0xd3, 0xff, 0xb5, 0xe4, 0x56, 0x20, 0x6b, 0x5b, 0x92, 0x90, 0x66, 0x31, 0xff,
+	0xa9, 0x7f, 0x03, 0x77, 0xe7, 0xf4, 0x19, 0xb1, 0x07, 0x2a, 0x40, 0x12, 0x8c, 0x3a, 0x6c, 0xeb,
+	0xef, 0xe1, 0x4e, 0x77, 0x42, 0x4f, 0xc9, 0xff, 0x61, 0xac, 0x2f, 0xa0, 0x36, 0xdb, 0xa5, 0x0a,
+	0x75, 0x1d, 0x72, 0x63, 0x8e, 0x05, 0x81, 0xaa, 0x96, 0x7e, 0x01, 0xb9, 0x9e, 0xac, 0xd4, 0xe7,
+	0x3d, 0x04, 0xcc, 0xdf, 0x27, 0x99, 0x8f, 0xd9, 0x27, 0xd9, 0xf9, 0xfb, 0xe4, 0x3b, 0xa8, 0xf4,
+	0x08, 0xeb, 0xa9, 0x87, 0x00, 0x99, 0x97, 0x1f, 0xfd, 0x88, 0x51, 0x0e, 0x3e, 0x62, 0x54, 0x61,
+	0x35, 0x66, 0xad, 0x4e, 0xb7, 0x27, 0x01, 0xf3, 0xf8, 0x51, 0xaf, 0x11, 0xd9, 0x48, 0xb9, 0x58,
+	0x03, 0xc4, 0xf7, 0x8a, 0x94, 0x06, 0xf3, 0xa5, 0xff, 0x0a, 0xaa, 0x09, 0xa9, 0x4a, 0xe9, 0x13,
+	0xfe, 0x9d, 0x5a, 0x88, 0x66, 0xde, 0xa0, 0x94, 0xdb, 0x00, 0xdf, 0x32, 0xa0, 0x14, 0xfb, 0x8f,
+	0x22, 0x74, 0x1f, 0x6a, 0x7b, 0x8d, 0xd6, 0xe1, 0x31, 0x6e, 0x1a, 0xed, 0xce, 0x6e, 0xd3, 0xd8,
+	0x6e, 0xf6, 0xfa, 0x46, 0x73, 0x6f, 0xaf, 0x83, 0xfb, 0x95, 0x5b, 0xe8, 0x2e, 0xdc, 0x4e, 0xa0,
+	0xb8, 0xf9, 0xfa, 0xb8, 0x85, 0x9b, 0xbb, 0x15, 0x0d, 0xdd, 0x81, 0x6a, 0x02, 0x7a, 0x7d, 0xdc,
+	0xc1, 0xc7, 0xed, 0x4a, 0x66, 0xab, 0x0b, 0x95, 0xf4, 0x47, 0x6e, 0xf4, 0x10, 0xee, 0xf5, 0xdf,
+	0xb4, 0x70, 0xd7, 0xd8, 0xe9, 0x1c, 0xf5, 0x9b, 0x47, 0x7d, 0xa3, 0xff, 0xb6, 0xdb, 0x34, 0xba,
+	0xb8, 0xd3, 0xef, 0x6c, 0x1f, 0xef, 0x55, 0x6e, 0xa1, 0x7b, 0x70, 0x67, 0x8e, 0xc2, 0xab, 0x5e,
+	0xe7, 0xa8, 0xa2, 0x6d, 0xfd, 0xad, 0x06, 0x2b, 0xa9, 0x2a, 0x1f, 0x6d, 0xc0, 0xdd, 0xce, 0xaf,
+	0x9b, 0xd8, 0x38, 0x6c, 0xb5, 0x5b, 0x7d, 0xa3, 0xb1, 0xd3, 0x6f, 0x75, 0x8e, 0x8c, 0xdd, 0xe6,
+	0x5e, 0xe3, 0xf8, 0x90, 0x07, 0x5e, 0x87, 0xf5, 0x39, 0x30, 0xee, 0x74, 0x2b, 0x1a, 0xef, 0x6b,
+	0xae, 0x69, 0x13, 0x57, 0x32, 0xf3, 0x0d, 0xdf, 0x34, 0x5a, 0xfd, 0x4a, 0x76, 0x3e, 0xc6, 0x93,
+	0x50, 0x59, 0xd8, 0x7a, 0x03, 0x10, 0x3d, 0xb2, 0xca, 0xe4, 0x1c, 0x75, 0x8e, 0xfb, 0x32, 0x37,
+	0x5d, 0xdc, 0x6a, 0x37, 0xf0, 0xdb, 0x20, 0xa1, 0x11, 0xd0, 0xd8, 0xdf, 0xc7, 0xcd, 0xfd, 0x46,
+	0xbf, 0x59, 0xd1, 0xd0, 0x6d, 0x58, 0x8d, 0x43, 0x07, 0xcd, 0xdd, 0xfd, 0x66, 0x25, 0xb3, 0xf5,
+	0x03, 0xac, 0xce, 0xd4, 0xe6, 0x48, 0x87, 0x07, 0xa1, 0xa9, 0xd1, 0xeb, 0xe3, 0x46, 0xbf, 0xb9,
+	0xff, 0xd6, 0xd8, 0x7e, 0x6b, 0x34, 0x8f, 0x76, 0xbb, 0x9d, 0xd6, 0x11, 0x4f, 0xc1, 0x23, 0xd8,
+	0x98, 0xa3, 0xb3, 0xdb, 0x6c, 0x76, 0x8d, 0x76, 0x13, 0xef, 0x37, 0x2b, 0xda, 0x96, 0x0d, 0xcb,
+	0xc9, 0xc2, 0x1d, 0x3d, 0x80, 0xfa, 0x4e, 0xe7, 0x68, 0xef, 0xb0, 0xb5, 0xd3, 0x37, 0xba, 0x9d,
+	0xc3, 0xd6, 0xce, 0x5b, 0x63, 0xaf, 0x85, 0x7b, 0x7d, 0xe3, 0x4d, 0xeb, 0xa8, 0x57, 0xb9, 0xc5,
+	0xd3, 0x9e, 0xc6, 0x0f, 0x1b, 0x01, 0xac, 0xa1, 0x1a, 0xac, 0xcd, 0x98, 0xf3, 0xfc, 0x64, 0xb6,
+	0x5e, 0x43, 0x75, 0x4e, 0x69, 0xc9, 0xf3, 0xc1, 0x53, 0xda, 0x6b, 0xfd, 0xd0, 0xdc, 0x35, 0xb6,
+	0x3b, 0xbb, 0x6f, 0x0d, 0xdc, 0x7c, 0xd5, 0xdc, 0xe1, 0xf1, 0x3f, 0x84, 0x7b, 0x29, 0x48, 0xa5,
+	0xd1, 0xe8, 0x1c, 0x1d, 0xbe, 0xad, 0x68, 0x2f, 0xfe, 0xab, 0x00, 0xe5, 0x86, 0x35, 0xb2, 0xdd,
+	0x9e, 0xfa, 0x9f, 0x8c, 0x6d, 0x28, 0x86, 0x75, 0x26, 0x8a, 0x1e, 0x90, 0xd3, 0xa5, 0x6a, 0xbd,
+	0x3e, 0x0f, 0x52, 0x3b, 0xe9, 0x7b, 0x28, 0xc7, 0xab, 0x33, 0x14, 0x55, 0xc6, 0x73, 0x8a, 0xc9,
+	0xfa, 0xc6, 0x35, 0xa8, 0x72, 0xf6, 0x0a, 0xca, 0xf1, 0xc2, 0x24, 0xe6, 0x6c, 0x4e, 0x31, 0x57,
+	0xff, 0x20, 0xca, 0x03, 0x8b, 0x17, 0x25, 0x31, 0x5f, 0x73, 0x6a, 0x9c, 0xfa, 0xc6, 0x35, 0xa8,
+	0x0a, 0xec, 0x08, 0x96, 0x12, 0xb5, 0x06, 0xda, 0x88, 0xa7, 0x64, 0xa6, 0xc2, 0xa9, 0x3f, 0xb8,
+	0x0e, 0x56, 0xfe, 0xfe, 0x04, 0xaa, 0x73, 0xf8, 0x26, 0xfa, 0x24, 0xfe, 0xef, 0x00, 0xd7, 0x10,
+	0xe4, 0xfa, 0xcf, 0x3e, 0xac, 0x14, 0xcd, 0x4b, 0x9c, 0x47, 0xc6, 0x86, 0x3f, 0x87, 0xc9, 0xd6,
+	0x37, 0xae, 0x41, 0x95, 0xb3, 0x3e, 0xac, 0xa4, 0x78, 0x08, 0x7a, 0x18, 0x5a, 0xcc, 0xa7, 0x4c,
+	0xf5, 0xcd, 0xeb, 0x15, 0x12, 0x49, 0x8d, 0x90, 0x64, 0x52, 0x67, 0x28, 0x4a, 0xfd, 0xc1, 0x75,
+	0xb0, 0xf2, 0xf7, 0x03, 0xac, 0xce, 0xdc, 0xf7, 0xe8, 0xd1, 0xf3, 0xe8, 0xdf, 0x1e, 0xe6, 0xf3,
+	0x8f, 0xba, 0xfe, 0x21, 0x15, 0xe5, 0xfb, 0x0d, 0x54, 0xd2, 0xf7, 0x33, 0x8a, 0x46, 0x78, 0x0d,
+	0x5b, 0xa8, 0x3f, 0xfa, 0x80, 0x86, 0x72, 0xbc, 0x0d, 0xc5, 0xf0, 0x3a, 0x8c, 0xed, 0xc1, 0xf4,
+	0x05, 0x5b, 0xaf, 0xcf, 0x83, 0xa2, 0xb9, 0x8e, 0x5f, 0x89, 0x33, 0x4b, 0x3d, 0xe9, 0x69, 0xe3,
+	0x1a, 0x54, 0x39, 0x3b, 0x80, 0x52, 0xec, 0xc6, 0x44, 0xf7, 0x12, 0xd3, 0x98, 0xbc, 0x5d, 0xeb,
+	0xf7, 0xe7, 0x83, 0xea, 0xf5, 0x71, 0xeb, 0x87, 0xc7, 0xa7, 0x36, 0x3b, 0x9b, 0x9c, 0x3c, 0x1f,
+	0x78, 0xa3, 0xcf, 0x94, 0x66, 0xf8, 0x57, 0xfc, 0x43, 0xf0, 0xb7, 0xaa, 0x75, 0x92, 0x13, 0xcd,
+	0x2f, 0xff, 0x7b, 0x00, 0xe1, 0xb3, 0x98, 0xd5, 0x36, 0x2c, 0x00, 0x00,
 }
@@ -0,0 +1,261 @@
+// Package ratelimit implements rate limits shared by the peers of a
+// cluster. Each key is owned by a single peer, picked with the same
+// consistent hash groupcache uses to pick the owner of a cache key.
+// The owner keeps the key's token bucket, and the other peers ask the
+// owner whether a request is allowed.
+package ratelimit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dfanout/dfanout/logging"
+	"github.com/mailgun/groupcache/consistenthash"
+	"github.com/mailgun/groupcache/lru"
+)
+
+// BasePath is the path peers serve the rate limits they own at.
+const BasePath = "/_ratelimit/"
+
+const (
+	// replicas is the number of replicas of each peer in the
+	// consistent hash, the default of groupcache.HTTPPool.
+	replicas = 50
+
+	// maxKeys is the max number of token buckets a peer keeps.
+	// The least recently used buckets are evicted.
+	maxKeys = 100000
+
+	// peerTimeout is the timeout of asking the owner of a key.
+	peerTimeout = 500 * time.Millisecond
+
+	// minRetryAfter is the min wait of a request that isn't allowed,
+	// so callers waiting for the next token don't spin.
+	minRetryAfter = time.Millisecond
+)
+
+// Limit is a rate limit of a key.
+type Limit struct {
+	// Rate is the number of requests allowed per second.
+	Rate float64
+
+	// Burst is the max number of requests allowed at once.
+	Burst int
+}
+
+// Options are the options of a Limiter.
+type Options struct {
+	// Transport sends the requests to the owners of the keys, e.g.
+	// to authenticate them. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	// Lookup returns the limit of a key owned by this peer. The
+	// other peers send only the keys, so callers can't change the
+	// limits. If nil, this peer doesn't answer the other peers.
+	Lookup func(ctx context.Context, key string) (Limit, error)
+}
+
+// Limiter enforces rate limits across the peers of a cluster.
+// It is safe for concurrent use.
+type Limiter struct {
+	self   string
+	client *http.Client
+	lookup func(ctx context.Context, key string) (Limit, error)
+
+	mu    sync.Mutex
+	peers *consistenthash.Map
+	n     int
+
+	bucketsMu sync.Mutex
+	buckets   *lru.Cache // key -> *bucket
+}
+
+// New returns a limiter for the peer with the given base URL,
+// e.g. "http://10.0.0.1:8080". Peers are in the same form and
+// should include self. If there are no peers, self owns all keys.
+func New(self string, peers []string, opts Options) *Limiter {
+	l := &Limiter{
+		self:    self,
+		client:  &http.Client{Transport: opts.Transport, Timeout: peerTimeout},
+		lookup:  opts.Lookup,
+		buckets: lru.New(maxKeys),
+	}
+	l.Set(peers...)
+	return l
+}
+
+// Set updates the peers.
+func (l *Limiter) Set(peers ...string) {
+	m := consistenthash.New(replicas, nil)
+	m.Add(peers...)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.peers = m
+	l.n = len(peers)
+}
+
+// Peers returns the number of peers, at least one.
+func (l *Limiter) Peers() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return max(1, l.n)
+}
+
+// owner returns the base URL of the peer that owns the key.
+func (l *Limiter) owner(key string) string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.peers.IsEmpty() {
+		return l.self
+	}
+	return l.peers.Get(key)
+}
+
+// Allow reports whether a request with the given key is allowed. If
+// not, it returns how long to wait before the next request is allowed,
+// at least a millisecond.
+// The owner of the key checks the request against the limit it looks
+// up. If the owner can't be reached, the request is checked against
+// a bucket on this peer with the given limit.
+func (l *Limiter) Allow(ctx context.Context, key string, limit Limit) (bool, time.Duration) {
+	owner := l.owner(key)
+	if owner == l.self {
+		return l.allow(key, limit, time.Now())
+	}
+	d, err := l.ask(ctx, owner, key)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Cannot reach the owner of the rate limit",
+			"owner", owner, logging.Error, err)
+		return l.allow(key, limit, time.Now())
+	}
+	if d.Allowed {
+		return true, 0
+	}
+	return false, max(minRetryAfter, time.Duration(d.RetryAfterUs)*time.Microsecond)
+}
+
+type request struct {
+	Key string `json:"key"`
+}
+
+type decision struct {
+	Allowed      bool  `json:"allowed"`
+	RetryAfterUs int64 `json:"retry_after_us,omitempty"`
+}
+
+func (l *Limiter) ask(ctx context.Context, owner, key string) (*decision, error) {
+	body, err := json.Marshal(&request{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, owner+BasePath, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d", resp.StatusCode)
+	}
+	var d decision
+	if err := json.NewDecoder(resp.Body).Decode(&d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// ServeHTTP answers whether a request is allowed
+// for the keys owned by this peer.
+func (l *Limiter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if l.lookup == nil {
+		w.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "invalid request: %v", err)
+		return
+	}
+	// The peers asking may fall back to their own buckets.
+	limit, err := l.lookup(r.Context(), req.Key)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "cannot look up the limit: %v", err)
+		return
+	}
+	allowed, retryAfter := l.allow(req.Key, limit, time.Now())
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(&decision{
+		Allowed:      allowed,
+		RetryAfterUs: retryAfter.Microseconds(),
+	}); err != nil {
+		slog.Error("Cannot write the rate limit decision", logging.Error, err)
+	}
+}
+
+// allow takes a token from the key's bucket on this peer.
+func (l *Limiter) allow(key string, limit Limit, now time.Time) (bool, time.Duration) {
+	l.bucketsMu.Lock()
+	defer l.bucketsMu.Unlock()
+
+	var b *bucket
+	if v, ok := l.buckets.Get(key); ok {
+		b = v.(*bucket)
+	} else {
+		b = &bucket{tokens: float64(burst(limit)), last: now}
+		l.buckets.Add(key, b, time.Time{})
+	}
+	return b.take(limit, now)
+}
+
+// burst returns the limit's burst, defaulting to the
+// rate rounded up, and at least one request.
+func burst(limit Limit) int {
+	if limit.Burst > 0 {
+		return limit.Burst
+	}
+	return int(math.Max(1, math.Ceil(limit.Rate)))
+}
+
+// bucket is a token bucket. It is refilled at the
+// limit's rate, up to the limit's burst.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func (b *bucket) take(limit Limit, now time.Time) (bool, time.Duration) {
+	capacity := float64(burst(limit))
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(capacity, b.tokens+elapsed*limit.Rate)
+		b.last = now
+	}
+	// The limit may have been lowered since the bucket was filled.
+	b.tokens = math.Min(capacity, b.tokens)
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	if limit.Rate <= 0 {
+		return false, time.Second
+	}
+	wait := (1 - b.tokens) / limit.Rate
+	return false, max(minRetryAfter, time.Duration(wait*float64(time.Second)))
+}
@@ -0,0 +1,244 @@
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBucket(t *testing.T) {
+	type take struct {
+		at      time.Duration // since the bucket was created
+		limit   Limit
+		allowed bool
+		wait    time.Duration
+	}
+	tests := []struct {
+		name  string
+		burst int // initial tokens
+		takes []take
+	}{
+		{
+			name:  "burst",
+			burst: 2,
+			takes: []take{
+				{limit: Limit{Rate: 1, Burst: 2}, allowed: true},
+				{limit: Limit{Rate: 1, Burst: 2}, allowed: true},
+				{limit: Limit{Rate: 1, Burst: 2}, wait: time.Second},
+			},
+		},
+		{
+			name:  "refill",
+			burst: 1,
+			takes: []take{
+				{limit: Limit{Rate: 4}, allowed: true},
+				{at: 100 * time.Millisecond, limit: Limit{Rate: 4}, wait: 150 * time.Millisecond},
+				{at: 250 * time.Millisecond, limit: Limit{Rate: 4}, allowed: true},
+			},
+		},
+		{
+			name:  "refill up to the burst",
+			burst: 2,
+			takes: []take{
+				{at: time.Hour, limit: Limit{Rate: 1, Burst: 2}, allowed: true},
+				{at: time.Hour, limit: Limit{Rate: 1, Burst: 2}, allowed: true},
+				{at: time.Hour, limit: Limit{Rate: 1, Burst: 2}, wait: time.Second},
+			},
+		},
+		{
+			name:  "lowered burst",
+			burst: 10,
+			takes: []take{
+				{limit: Limit{Rate: 1, Burst: 1}, allowed: true},
+				{limit: Limit{Rate: 1, Burst: 1}, wait: time.Second},
+			},
+		},
+		{
+			name:  "sub-millisecond wait",
+			burst: 1,
+			takes: []take{
+				{limit: Limit{Rate: 10000}, allowed: true},
+				{at: 50 * time.Microsecond, limit: Limit{Rate: 10000}, wait: time.Millisecond},
+			},
+		},
+		{
+			name:  "zero rate",
+			burst: 1,
+			takes: []take{
+				{limit: Limit{Rate: 0, Burst: 1}, allowed: true},
+				{at: time.Hour, limit: Limit{Rate: 0, Burst: 1}, wait: time.Second},
+			},
+		},
+	}
+	for _, tt := range tests {
+		start := time.Unix(1000, 0)
+		b := &bucket{tokens: float64(tt.burst), last: start}
+		for i, take := range tt.takes {
+			allowed, wait := b.take(take.limit, start.Add(take.at))
+			if allowed != take.allowed || wait != take.wait {
+				t.Errorf("%s: take %d = %v, %v, want %v, %v", tt.name, i, allowed, wait, take.allowed, take.wait)
+			}
+		}
+	}
+}
+
+func TestBurst(t *testing.T) {
+	tests := []struct {
+		limit Limit
+		want  int
+	}{
+		{limit: Limit{Rate: 10, Burst: 3}, want: 3},
+		{limit: Limit{Rate: 2.5}, want: 3},
+		{limit: Limit{Rate: 0.1}, want: 1},
+		{limit: Limit{}, want: 1},
+	}
+	for _, tt := range tests {
+		if got := burst(tt.limit); got != tt.want {
+			t.Errorf("burst(%+v) = %d, want %d", tt.limit, got, tt.want)
+		}
+	}
+}
+
+func TestAllowKeys(t *testing.T) {
+	l := New("http://self", nil, Options{})
+	limit := Limit{Rate: 1, Burst: 1}
+	now := time.Now()
+	if ok, _ := l.allow("a", limit, now); !ok {
+		t.Error("first request of a is not allowed")
+	}
+	if ok, _ := l.allow("a", limit, now); ok {
+		t.Error("second request of a is allowed")
+	}
+	if ok, _ := l.allow("b", limit, now); !ok {
+		t.Error("first request of b is not allowed")
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	lookup := func(ctx context.Context, key string) (Limit, error) {
+		if key == "unknown" {
+			return Limit{}, errors.New("no limit")
+		}
+		return Limit{Rate: 1, Burst: 2}, nil
+	}
+	type response struct {
+		code    int
+		allowed bool
+	}
+	tests := []struct {
+		name   string
+		lookup func(ctx context.Context, key string) (Limit, error)
+		method string
+		body   string
+		want   []response // of consecutive requests
+	}{
+		{
+			name:   "limit is looked up",
+			lookup: lookup,
+			method: http.MethodPost,
+			// The rate and the burst of callers are ignored.
+			body: `{"key":"k","rate":1000,"burst":1000}`,
+			want: []response{{200, true}, {200, true}, {200, false}},
+		},
+		{
+			name:   "unknown key",
+			lookup: lookup,
+			method: http.MethodPost,
+			body:   `{"key":"unknown"}`,
+			want:   []response{{code: 500}},
+		},
+		{
+			name:   "no lookup",
+			method: http.MethodPost,
+			body:   `{"key":"k"}`,
+			want:   []response{{code: 501}},
+		},
+		{
+			name:   "method",
+			lookup: lookup,
+			method: http.MethodGet,
+			want:   []response{{code: 405}},
+		},
+		{
+			name:   "invalid request",
+			lookup: lookup,
+			method: http.MethodPost,
+			body:   `{`,
+			want:   []response{{code: 400}},
+		},
+	}
+	for _, tt := range tests {
+		l := New("http://self", nil, Options{Lookup: tt.lookup})
+		for i, want := range tt.want {
+			w := httptest.NewRecorder()
+			l.ServeHTTP(w, httptest.NewRequest(tt.method, BasePath, strings.NewReader(tt.body)))
+			if w.Code != want.code {
+				t.Errorf("%s: request %d status = %d, want %d", tt.name, i, w.Code, want.code)
+				continue
+			}
+			if w.Code != http.StatusOK {
+				continue
+			}
+			var d decision
+			if err := json.Unmarshal(w.Body.Bytes(), &d); err != nil {
+				t.Fatal(err)
+			}
+			if d.Allowed != want.allowed || (!d.Allowed && d.RetryAfterUs <= 0) {
+				t.Errorf("%s: request %d = %+v, want allowed: %v", tt.name, i, d, want.allowed)
+			}
+		}
+	}
+}
+
+func TestAsk(t *testing.T) {
+	owner := New("", nil, Options{Lookup: func(ctx context.Context, key string) (Limit, error) {
+		return Limit{Rate: 1, Burst: 1}, nil
+	}})
+	s := httptest.NewServer(owner)
+	defer s.Close()
+
+	// The owner's limit applies, not the limit of the peer asking.
+	peers := []string{s.URL, "http://peer"}
+	l := New("http://peer", peers, Options{})
+	var key string
+	for i := 0; key == "" && i < 1000; i++ {
+		if k := strconv.Itoa(i); l.owner(k) == s.URL {
+			key = k
+		}
+	}
+	if key == "" {
+		t.Fatal("no key is owned by the server")
+	}
+	limit := Limit{Rate: 100, Burst: 100}
+	if ok, _ := l.Allow(context.Background(), key, limit); !ok {
+		t.Error("first request is not allowed")
+	}
+	if ok, retryAfter := l.Allow(context.Background(), key, limit); ok || retryAfter <= 0 {
+		t.Errorf("second request = %v, %v, want rejected by the owner", ok, retryAfter)
+	}
+
+	// If the owner can't answer, the peer's bucket is used.
+	s.Close()
+	if ok, _ := l.Allow(context.Background(), key, limit); !ok {
+		t.Error("request is not allowed by the peer's bucket")
+	}
+}
+
+func TestAskMinRetryAfter(t *testing.T) {
+	// The owner's wait may round down to zero, e.g. with high rates.
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"allowed":false}`))
+	}))
+	defer s.Close()
+
+	l := New("http://peer", []string{s.URL}, Options{})
+	if ok, retryAfter := l.Allow(context.Background(), "k", Limit{Rate: 1}); ok || retryAfter < minRetryAfter {
+		t.Errorf("Allow() = %v, %v, want rejected for at least %v", ok, retryAfter, minRetryAfter)
+	}
+}
@@ -0,0 +1,451 @@
+// Package secrets resolves the secrets referenced by fanout configs.
+// References are in the form of "env:NAME" for environment variables,
+// "file:/path" for files, and "db:name" for the secrets table, where
+// secrets are encrypted with AES-256-GCM by a local master key. A
+// Policy limits the environment variables and the files they name.
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dfanout/dfanout/logging"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Redacted replaces secret values in the configs returned by the
+// admin service and in the debug pages.
+const Redacted = "REDACTED"
+
+// DefaultPollInterval is the default interval of checking
+// the resolved secrets for changes.
+const DefaultPollInterval = 30 * time.Second
+
+// KeySize is the size of the master key, for AES-256.
+const KeySize = 32
+
+// ErrNotFound is returned when a secret doesn't exist.
+var ErrNotFound = errors.New("secret not found")
+
+// DefaultEnvPrefix is the default prefix of the
+// environment variables env references can name.
+const DefaultEnvPrefix = "DFANOUT_SECRET_"
+
+// Reference kinds.
+const (
+	envPrefix  = "env:"
+	filePrefix = "file:"
+	dbPrefix   = "db:"
+)
+
+// Validate checks the syntax of a reference.
+func Validate(ref string) error {
+	for _, prefix := range []string{envPrefix, filePrefix, dbPrefix} {
+		if name, ok := strings.CutPrefix(ref, prefix); ok {
+			if name == "" {
+				return fmt.Errorf("secret reference %q has no name", ref)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("secret reference should start with env:, file: or db:, found %q", ref)
+}
+
+// DBName returns the name of the secret in the secrets
+// table if the reference is a db reference.
+func DBName(ref string) (string, bool) {
+	name, ok := strings.CutPrefix(ref, dbPrefix)
+	return name, ok && name != ""
+}
+
+// Policy limits the environment variables and the files references
+// can name, so fanout configs can't read the node's other secrets,
+// e.g. the master key or the database credentials.
+type Policy struct {
+	// EnvPrefix is the prefix of the environment variables env
+	// references can name. If empty, env references aren't allowed.
+	EnvPrefix string
+
+	// Dir is the directory of the files file references can name.
+	// If empty, file references aren't allowed.
+	Dir string
+}
+
+// Check validates the reference, and checks that
+// the policy allows it.
+func (p Policy) Check(ref string) error {
+	if err := Validate(ref); err != nil {
+		return err
+	}
+	switch {
+	case strings.HasPrefix(ref, envPrefix):
+		if p.EnvPrefix == "" {
+			return fmt.Errorf("secret reference %q isn't allowed, env references are disabled", ref)
+		}
+		if !strings.HasPrefix(strings.TrimPrefix(ref, envPrefix), p.EnvPrefix) {
+			return fmt.Errorf("secret reference %q isn't allowed, environment variables should start with %q", ref, p.EnvPrefix)
+		}
+	case strings.HasPrefix(ref, filePrefix):
+		if p.Dir == "" {
+			return fmt.Errorf("secret reference %q isn't allowed, file references are disabled", ref)
+		}
+		if !within(p.Dir, strings.TrimPrefix(ref, filePrefix)) {
+			return fmt.Errorf("secret reference %q isn't allowed, files should be in %q", ref, p.Dir)
+		}
+	}
+	return nil
+}
+
+// within reports whether the path is in the directory.
+func within(dir, path string) bool {
+	if !filepath.IsAbs(path) {
+		return false
+	}
+	rel, err := filepath.Rel(dir, filepath.Clean(path))
+	return err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// Info describes a secret of the secrets table, without its value.
+type Info struct {
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Store resolves references to secrets, and keeps the resolved values
+// until they change. It is safe for concurrent use.
+type Store struct {
+	pool   *pgxpool.Pool
+	aead   cipher.AEAD // nil if there is no master key
+	policy Policy
+
+	mu       sync.Mutex
+	values   map[string][]byte // ref -> value
+	onChange []func(ref string)
+}
+
+// New returns a store with the secrets table in the pool, encrypted
+// with the master key, that resolves the references the policy allows.
+// If the master key is nil, secrets of the table can't be resolved or set.
+func New(pool *pgxpool.Pool, masterKey []byte, policy Policy) (*Store, error) {
+	if policy.Dir != "" {
+		dir, err := filepath.Abs(policy.Dir)
+		if err != nil {
+			return nil, err
+		}
+		policy.Dir = dir
+	}
+	s := &Store{pool: pool, policy: policy, values: make(map[string][]byte)}
+	if masterKey != nil {
+		if len(masterKey) != KeySize {
+			return nil, fmt.Errorf("master key should be %d bytes, found %d", KeySize, len(masterKey))
+		}
+		block, err := aes.NewCipher(masterKey)
+		if err != nil {
+			return nil, err
+		}
+		if s.aead, err = cipher.NewGCM(block); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// LoadMasterKey reads a base64 encoded master key from the file.
+func LoadMasterKey(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("master key should be base64 encoded: %w", err)
+	}
+	return key, nil
+}
+
+// Check validates the reference, and checks that
+// the store's policy allows it.
+func (s *Store) Check(ref string) error {
+	if s == nil {
+		return fmt.Errorf("secret reference %q isn't allowed, secrets aren't configured", ref)
+	}
+	return s.policy.Check(ref)
+}
+
+// OnChange registers a function that is called
+// when the value of a resolved reference changes.
+func (s *Store) OnChange(f func(ref string)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onChange = append(s.onChange, f)
+}
+
+// Resolve returns the value of the referenced secret.
+func (s *Store) Resolve(ctx context.Context, ref string) ([]byte, error) {
+	if s == nil {
+		return nil, fmt.Errorf("cannot resolve %q, secrets aren't configured", ref)
+	}
+	s.mu.Lock()
+	v, ok := s.values[ref]
+	s.mu.Unlock()
+	if ok {
+		return v, nil
+	}
+
+	v, err := s.load(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve %q: %w", ref, err)
+	}
+	s.mu.Lock()
+	s.values[ref] = v
+	s.mu.Unlock()
+	return v, nil
+}
+
+func (s *Store) load(ctx context.Context, ref string) ([]byte, error) {
+	// Configs written before the policy changed may not be allowed.
+	if err := s.policy.Check(ref); err != nil {
+		return nil, err
+	}
+	switch {
+	case strings.HasPrefix(ref, envPrefix):
+		v, ok := os.LookupEnv(strings.TrimPrefix(ref, envPrefix))
+		if !ok {
+			return nil, ErrNotFound
+		}
+		return []byte(v), nil
+	case strings.HasPrefix(ref, filePrefix):
+		return s.readFile(strings.TrimPrefix(ref, filePrefix))
+	default:
+		return s.get(ctx, strings.TrimPrefix(ref, dbPrefix))
+	}
+}
+
+// readFile reads a file of the policy's directory,
+// rejecting symbolic links to files out of it.
+func (s *Store) readFile(path string) ([]byte, error) {
+	dir, err := filepath.EvalSymlinks(s.policy.Dir)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return nil, err
+	}
+	if !within(dir, resolved) {
+		return nil, fmt.Errorf("file links out of %q", s.policy.Dir)
+	}
+	return os.ReadFile(resolved)
+}
+
+// get reads and decrypts a secret of the secrets table.
+func (s *Store) get(ctx context.Context, name string) ([]byte, error) {
+	if s.aead == nil {
+		return nil, errors.New("no master key to decrypt the secret")
+	}
+	var nonce, ciphertext []byte
+	err := s.pool.QueryRow(ctx,
+		`SELECT nonce, ciphertext FROM secrets WHERE name = $1`, name).Scan(&nonce, &ciphertext)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.open(name, nonce, ciphertext)
+}
+
+// seal encrypts the value of the named secret.
+func (s *Store) seal(name string, value []byte) (nonce, ciphertext []byte, err error) {
+	nonce = make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	// The name is authenticated, so ciphertexts
+	// can't be swapped between secrets.
+	return nonce, s.aead.Seal(nil, nonce, value, []byte(name)), nil
+}
+
+// open decrypts the value of the named secret.
+func (s *Store) open(name string, nonce, ciphertext []byte) ([]byte, error) {
+	if len(nonce) != s.aead.NonceSize() {
+		return nil, errors.New("cannot decrypt the secret, its nonce is invalid")
+	}
+	v, err := s.aead.Open(nil, nonce, ciphertext, []byte(name))
+	if err != nil {
+		return nil, errors.New("cannot decrypt the secret, the master key may have changed")
+	}
+	return v, nil
+}
+
+// Set encrypts and stores a secret in the secrets table, replacing
+// its value if it exists. Resolved references to it are refreshed on
+// this node, and on the other nodes when they poll for changes.
+func (s *Store) Set(ctx context.Context, name string, value []byte) error {
+	if s.aead == nil {
+		return errors.New("no master key to encrypt the secret")
+	}
+	nonce, ciphertext, err := s.seal(name, value)
+	if err != nil {
+		return err
+	}
+	_, err = s.pool.Exec(ctx,
+		`INSERT INTO secrets (name, nonce, ciphertext, created_at, updated_at)
+		 VALUES ($1, $2, $3, NOW(), NOW())
+		 ON CONFLICT (name) DO UPDATE SET nonce = $2, ciphertext = $3, updated_at = NOW()`,
+		name, nonce, ciphertext)
+	if err != nil {
+		return err
+	}
+	s.refresh(dbPrefix+name, value, true)
+	return nil
+}
+
+// Delete deletes a secret from the secrets table.
+func (s *Store) Delete(ctx context.Context, name string) error {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM secrets WHERE name = $1`, name)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	s.refresh(dbPrefix+name, nil, false)
+	return nil
+}
+
+// List lists the secrets of the secrets table, ordered by name.
+func (s *Store) List(ctx context.Context) ([]Info, error) {
+	rows, err := s.pool.Query(ctx,
+		`SELECT name, created_at, updated_at FROM secrets ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var infos []Info
+	for rows.Next() {
+		var i Info
+		if err := rows.Scan(&i.Name, &i.CreatedAt, &i.UpdatedAt); err != nil {
+			return nil, err
+		}
+		infos = append(infos, i)
+	}
+	return infos, rows.Err()
+}
+
+// Run reloads the resolved secrets at the interval until the
+// context is done, and notifies the changed references.
+func (s *Store) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.poll(ctx)
+		}
+	}
+}
+
+func (s *Store) poll(ctx context.Context) {
+	s.mu.Lock()
+	refs := make([]string, 0, len(s.values))
+	for ref := range s.values {
+		refs = append(refs, ref)
+	}
+	s.mu.Unlock()
+
+	for _, ref := range refs {
+		v, err := s.load(ctx, ref)
+		switch {
+		case errors.Is(err, ErrNotFound) || errors.Is(err, os.ErrNotExist):
+			s.refresh(ref, nil, false)
+		case err != nil:
+			// Keep serving the last value.
+			slog.Warn("Cannot reload the secret", "ref", ref, logging.Error, err)
+		default:
+			s.refresh(ref, v, true)
+		}
+	}
+}
+
+// refresh updates the resolved value of the reference, and notifies
+// the change. References that aren't resolved yet are skipped.
+func (s *Store) refresh(ref string, v []byte, ok bool) {
+	s.mu.Lock()
+	old, resolved := s.values[ref]
+	if !resolved || (ok && bytes.Equal(old, v)) {
+		s.mu.Unlock()
+		return
+	}
+	if ok {
+		s.values[ref] = v
+	} else {
+		delete(s.values, ref)
+	}
+	onChange := s.onChange
+	s.mu.Unlock()
+
+	slog.Info("Secret changed", "ref", ref)
+	for _, f := range onChange {
+		f(ref)
+	}
+}
+
+// RedactURL redacts the password in the URL, if any.
+func RedactURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil || u.User == nil {
+		return s
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), Redacted)
+	}
+	return u.String()
+}
+
+// sensitiveHeaders are the headers that carry credentials.
+var sensitiveHeaders = []string{
+	"authorization",
+	"proxy-authorization",
+	"cookie",
+	"set-cookie",
+	"x-api-key",
+}
+
+// SensitiveHeader reports whether the header is likely to carry
+// credentials, and its values should be redacted.
+func SensitiveHeader(name string) bool {
+	name = strings.ToLower(name)
+	for _, h := range sensitiveHeaders {
+		if name == h {
+			return true
+		}
+	}
+	for _, s := range []string{"token", "secret", "password", "api-key", "apikey"} {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
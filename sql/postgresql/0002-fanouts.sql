@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS fanouts (
+    fanout_name VARCHAR(1024) NOT NULL,
+    config JSON,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    PRIMARY KEY(fanout_name)
+);
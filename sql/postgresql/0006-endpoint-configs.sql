@@ -0,0 +1 @@
+ALTER TABLE endpoints ADD COLUMN IF NOT EXISTS config JSON;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS outbox (
+    id BIGSERIAL NOT NULL,
+    fanout_name VARCHAR(1024) NOT NULL,
+    endpoint_name VARCHAR(1024) NOT NULL,
+    method VARCHAR(16) NOT NULL,
+    url TEXT NOT NULL,
+    header JSON,
+    body BYTEA,
+    state VARCHAR(16) NOT NULL,
+    attempts INTEGER NOT NULL,
+    max_attempts INTEGER NOT NULL,
+    initial_backoff_ms BIGINT NOT NULL,
+    max_backoff_ms BIGINT NOT NULL,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    PRIMARY KEY(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_state_next_attempt_at ON outbox(state, next_attempt_at);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_fanout_name_endpoint_name_state ON outbox(fanout_name, endpoint_name, state);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS secrets (
+    name VARCHAR(1024) NOT NULL,
+    nonce BYTEA NOT NULL,
+    ciphertext BYTEA NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    PRIMARY KEY(name)
+);
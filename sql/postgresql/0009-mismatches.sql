@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS mismatches (
+    fanout_name VARCHAR(1024) NOT NULL,
+    endpoint_name VARCHAR(1024) NOT NULL,
+    compared BIGINT NOT NULL,
+    mismatched BIGINT NOT NULL,
+    PRIMARY KEY(fanout_name, endpoint_name)
+);
+
+CREATE TABLE IF NOT EXISTS mismatch_samples (
+    id BIGSERIAL NOT NULL,
+    fanout_name VARCHAR(1024) NOT NULL,
+    endpoint_name VARCHAR(1024) NOT NULL,
+    differences JSON NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_mismatch_samples_fanout_name_endpoint_name_created_at ON mismatch_samples(fanout_name, endpoint_name, created_at);